@@ -1,19 +1,26 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/urfave/cli"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/litl/rdstail/src"
+	"github.com/urfave/cli"
 )
 
 func fie(e error) {
@@ -24,7 +31,7 @@ func fie(e error) {
 }
 
 func signalListen(stop chan<- struct{}) {
-	c := make(chan os.Signal)
+	c := make(chan os.Signal, 1)
 	signal.Notify(c, syscall.SIGTERM, syscall.SIGINT)
 
 	<-c
@@ -33,6 +40,16 @@ func signalListen(stop chan<- struct{}) {
 	log.Panic("Aborting on second signal")
 }
 
+// logSyslogStats logs the counters of w each time rdstail gets SIGUSR1.
+func logSyslogStats(w *rdstail.SyslogWriter) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGUSR1)
+	for range c {
+		st := w.Stats()
+		log.Printf("syslog: %d reconnects, %d messages dropped, %d queued", st.Reconnects, st.Dropped, st.Queued)
+	}
+}
+
 func setupRDS(c *cli.Context) *rds.RDS {
 	region := c.GlobalString("region")
 	maxRetries := c.GlobalInt("max-retries")
@@ -40,29 +57,128 @@ func setupRDS(c *cli.Context) *rds.RDS {
 	return rds.New(session.New(), cfg)
 }
 
+func setupCloudWatchLogs(c *cli.Context) *cloudwatchlogs.CloudWatchLogs {
+	region := c.GlobalString("region")
+	maxRetries := c.GlobalInt("max-retries")
+	cfg := aws.NewConfig().WithRegion(region).WithMaxRetries(maxRetries)
+	if endpoint := c.String("endpoint"); endpoint != "" {
+		cfg = cfg.WithEndpoint(endpoint)
+	}
+	return cloudwatchlogs.New(session.New(), cfg)
+}
+
+func setupS3(c *cli.Context) *s3.S3 {
+	region := c.GlobalString("region")
+	maxRetries := c.GlobalInt("max-retries")
+	cfg := aws.NewConfig().WithRegion(region).WithMaxRetries(maxRetries)
+	if endpoint := c.String("endpoint"); endpoint != "" {
+		// S3 compatible servers rarely serve buckets as subdomains
+		cfg = cfg.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+	return s3.New(session.New(), cfg)
+}
+
 func parseRate(c *cli.Context) time.Duration {
 	rate, err := time.ParseDuration(c.String("rate"))
 	fie(err)
 	return rate
 }
 
-func parseDB(c *cli.Context) string {
-	db := c.GlobalString("instance")
-	if db == "" {
-		fie(errors.New("-instance required"))
+func parseSelector(c *cli.Context) rdstail.InstanceSelector {
+	sel := rdstail.InstanceSelector{
+		Names:    c.GlobalStringSlice("instance"),
+		Engines:  c.GlobalStringSlice("engine"),
+		Clusters: c.GlobalStringSlice("cluster"),
+	}
+	for _, tag := range c.GlobalStringSlice("tag") {
+		if sel.Tags == nil {
+			sel.Tags = make(map[string]string)
+		}
+		kv := strings.SplitN(tag, "=", 2)
+		if len(kv) == 2 {
+			sel.Tags[kv[0]] = kv[1]
+		} else {
+			sel.Tags[kv[0]] = ""
+		}
 	}
-	return db
+	if sel.Empty() {
+		fie(errors.New("-instance, -cluster, -tag or -engine required"))
+	}
+	return sel
+}
+
+func parseRefresh(c *cli.Context) time.Duration {
+	refresh, err := time.ParseDuration(c.GlobalString("refresh"))
+	fie(err)
+	return refresh
+}
+
+func parsePatterns(c *cli.Context) []string {
+	var patterns []string
+	for _, entry := range c.StringSlice("file-pattern") {
+		for _, pattern := range strings.Split(entry, ",") {
+			if pattern = strings.TrimSpace(pattern); pattern != "" {
+				patterns = append(patterns, pattern)
+			}
+		}
+	}
+
+	if logType := c.String("log-type"); logType != "" {
+		pattern, ok := rdstail.LogTypes[logType]
+		if !ok {
+			fie(fmt.Errorf("unknown -log-type %q, expected error, slow or general", logType))
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns
+}
+
+// parseTime reads a time flag given either as a duration before now, e.g. 2h,
+// or as an RFC 3339 time.
+func parseTime(c *cli.Context, name string) time.Time {
+	v := c.String(name)
+	if v == "" {
+		return time.Time{}
+	}
+	if d, err := time.ParseDuration(v); err == nil {
+		return time.Now().Add(-d)
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		fie(fmt.Errorf("-%s: expected a duration like 2h or a time like 2006-01-02T15:04:05Z", name))
+	}
+	return t
+}
+
+func parseStateFile(c *cli.Context) rdstail.CheckpointStore {
+	path := c.String("state-file")
+	if path == "" {
+		return nil
+	}
+	store, err := rdstail.NewFileCheckpointStore(path)
+	fie(err)
+	return store
 }
 
 func watch(c *cli.Context) {
 	r := setupRDS(c)
-	db := parseDB(c)
+	sel := parseSelector(c)
+	refresh := parseRefresh(c)
+	patterns := parsePatterns(c)
 	rate := parseRate(c)
+	store := parseStateFile(c)
 
 	stop := make(chan struct{})
 	go signalListen(stop)
 
-	err := rdstail.Watch(r, db, rate, func(lines string) error {
+	multi := sel.Multi()
+	err := rdstail.WatchInstances(r, sel, patterns, refresh, rate, store, func(inst rdstail.Instance, logFile, lines string) error {
+		if lines == "" {
+			return nil
+		}
+		if multi || len(patterns) > 1 {
+			lines = rdstail.PrefixLines(rdstail.LinePrefix(inst, logFile, multi, len(patterns) > 1), lines)
+		}
 		fmt.Print(lines)
 		return nil
 	}, stop)
@@ -70,36 +186,449 @@ func watch(c *cli.Context) {
 	fie(err)
 }
 
-func papertrail(c *cli.Context) {
-	r := setupRDS(c)
-	db := parseDB(c)
-	rate := parseRate(c)
-	papertrailHost := c.String("papertrail")
-	if papertrailHost == "" {
-		fie(errors.New("-papertrail required"))
-	}
-	appName := c.String("app")
+func parseHostname(c *cli.Context) string {
 	hostname := c.String("hostname")
 	if hostname == "os.Hostname()" {
 		var err error
 		hostname, err = os.Hostname()
 		fie(err)
 	}
+	return hostname
+}
+
+func feedSyslog(c *cli.Context, cfg rdstail.SyslogConfig) {
+	w, err := rdstail.DialSyslog(cfg)
+	fie(err)
+	go logSyslogStats(w)
+	feed(c, rdstail.NewSyslogSink(w, parseSelector(c).Multi(), len(parsePatterns(c)) > 1))
+}
+
+func papertrail(c *cli.Context) {
+	papertrailHost := c.String("papertrail")
+	if papertrailHost == "" {
+		fie(errors.New("-papertrail required"))
+	}
+	cfg := rdstail.PapertrailConfig(papertrailHost, c.String("app"), parseHostname(c))
+	cfg.CAFile = c.String("ca-file")
+	cfg.MaxSize = c.Int("max-size")
+	cfg.QueueSize = c.Int("queue-size")
+	feedSyslog(c, cfg)
+}
+
+func syslog(c *cli.Context) {
+	cfg := rdstail.SyslogConfig{
+		Network:   c.String("network"),
+		Addr:      c.String("addr"),
+		Format:    c.String("format"),
+		Framing:   c.String("framing"),
+		CAFile:    c.String("ca-file"),
+		Hostname:  parseHostname(c),
+		App:       c.String("app"),
+		MaxSize:   c.Int("max-size"),
+		QueueSize: c.Int("queue-size"),
+	}
+	if cfg.Addr == "" {
+		fie(errors.New("-addr required"))
+	}
+	var err error
+	cfg.Facility, err = rdstail.ParseFacility(c.String("facility"))
+	fie(err)
+	cfg.Severity, err = rdstail.ParseSeverity(c.String("severity"))
+	fie(err)
+	feedSyslog(c, cfg)
+}
+
+// feed parses the logs and sends them to sink until stopped by a signal.
+func feed(c *cli.Context, sink rdstail.Sink) {
+	r := setupRDS(c)
+	sel := parseSelector(c)
+	sel.LookupEngines = true
+	refresh := parseRefresh(c)
+	patterns := parsePatterns(c)
+	rate := parseRate(c)
+	store := parseStateFile(c)
 
 	stop := make(chan struct{})
 	go signalListen(stop)
 
-	err := rdstail.FeedPapertrail(r, db, rate, papertrailHost, appName, hostname, stop)
+	if c.String("spool") == "" {
+		err := rdstail.Feed(r, sel, patterns, refresh, rate, store, c.String("log-line-prefix"), sink, stop)
+		if closeErr := sink.Close(); err == nil {
+			err = closeErr
+		}
+		fie(err)
+		return
+	}
 
+	// Drain stops once Feed is done, however it ended, and has to be done
+	// itself before the spool and sink it uses are closed
+	spool := openSpool(c)
+	stopDrain := make(chan struct{})
+	drained := make(chan struct{})
+	go func() {
+		spool.Drain(sink, stopDrain)
+		close(drained)
+	}()
+	err := rdstail.Feed(r, sel, patterns, refresh, rate, store, c.String("log-line-prefix"), spool, stop)
+	close(stopDrain)
+	<-drained
+	if closeErr := spool.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := sink.Close(); err == nil {
+		err = closeErr
+	}
 	fie(err)
 }
 
+func openSpool(c *cli.Context) *rdstail.Spool {
+	var maxAge time.Duration
+	if v := c.String("spool-max-age"); v != "" {
+		var err error
+		maxAge, err = time.ParseDuration(v)
+		fie(err)
+	}
+	maxSize := int64(c.Int("spool-max-size")) * 1024 * 1024
+	spool, err := rdstail.OpenSpool(c.String("spool"), maxSize, maxAge)
+	fie(err)
+	return spool
+}
+
+// sinkFlags are the flags of the commands feeding a sink, followed by extra.
+func sinkFlags(extra ...cli.Flag) []cli.Flag {
+	return append([]cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "file-pattern, f",
+			Usage: "only follow log files matching this glob (error/*) or /regexp/. may be repeated, each pattern is followed on its own",
+		},
+		cli.StringFlag{
+			Name:  "log-type",
+			Usage: "only follow this mysql log: error, slow or general",
+		},
+		cli.StringFlag{
+			Name:  "log-line-prefix",
+			Usage: "log_line_prefix of the postgres instances, to parse their logs with. defaults to rds' %t:%r:%u@%d:[%p]:",
+		},
+		cli.StringFlag{
+			Name:  "rate, r",
+			Value: "3s",
+			Usage: "rds log polling rate",
+		},
+		cli.StringFlag{
+			Name:  "state-file",
+			Usage: "file to save the read position in, so a restart resumes where it left off",
+		},
+		cli.StringFlag{
+			Name:  "spool",
+			Usage: "directory to queue parsed logs in on their way out, so they survive the destination being down. best used with -state-file",
+		},
+		cli.IntFlag{
+			Name:  "spool-max-size",
+			Value: 1024,
+			Usage: "most megabytes to queue in the spool, past that reading rds waits for it to drain",
+		},
+		cli.StringFlag{
+			Name:  "spool-max-age",
+			Usage: "drop what has been in the spool longer than this e.g. 72h. kept until sent by default",
+		},
+	}, extra...)
+}
+
+func cloudwatch(c *cli.Context) {
+	group := c.String("group")
+	if group == "" {
+		fie(errors.New("-group required"))
+	}
+	sink := rdstail.NewCloudWatchSink(setupCloudWatchLogs(c), group, c.String("stream"))
+	feed(c, sink)
+}
+
+func parseHeaders(c *cli.Context) http.Header {
+	headers := make(http.Header)
+	for _, h := range c.StringSlice("header") {
+		kv := strings.SplitN(h, ":", 2)
+		if len(kv) != 2 {
+			fie(fmt.Errorf("-header %q: expected Name: value", h))
+		}
+		headers.Add(strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1]))
+	}
+	return headers
+}
+
+// parseUser splits the user:password of the -user flag.
+func parseUser(c *cli.Context) (string, string) {
+	kv := strings.SplitN(c.String("user"), ":", 2)
+	if len(kv) == 2 {
+		return kv[0], kv[1]
+	}
+	return kv[0], ""
+}
+
+func httpPost(c *cli.Context) {
+	cfg := rdstail.HTTPConfig{
+		URL:       c.String("url"),
+		Format:    c.String("format"),
+		Headers:   parseHeaders(c),
+		Gzip:      c.Bool("gzip"),
+		BatchSize: c.Int("batch-size"),
+	}
+	if cfg.URL == "" {
+		fie(errors.New("-url required"))
+	}
+
+	sink, err := rdstail.NewHTTPSink(cfg)
+	fie(err)
+	feed(c, sink)
+}
+
+func elasticsearch(c *cli.Context) {
+	cfg := rdstail.ElasticsearchConfig{
+		URL:       c.String("url"),
+		Index:     c.String("index"),
+		Headers:   parseHeaders(c),
+		Gzip:      c.Bool("gzip"),
+		BatchSize: c.Int("batch-size"),
+	}
+	cfg.Username, cfg.Password = parseUser(c)
+	feed(c, rdstail.NewElasticsearchSink(cfg))
+}
+
+func loki(c *cli.Context) {
+	cfg := rdstail.LokiConfig{
+		URL:     c.String("url"),
+		Format:  c.String("format"),
+		Labels:  make(map[string]string),
+		Headers: parseHeaders(c),
+	}
+	for _, label := range c.StringSlice("label") {
+		kv := strings.SplitN(label, "=", 2)
+		if len(kv) != 2 {
+			fie(fmt.Errorf("-label %q: expected name=value", label))
+		}
+		cfg.Labels[kv[0]] = kv[1]
+	}
+	cfg.Username, cfg.Password = parseUser(c)
+	sink, err := rdstail.NewLokiSink(cfg)
+	fie(err)
+	feed(c, sink)
+}
+
+func kafka(c *cli.Context) {
+	cfg := rdstail.KafkaConfig{
+		Compression: c.String("compression"),
+		Acks:        c.String("acks"),
+		BatchSize:   c.Int("batch-size"),
+		TLS:         c.Bool("tls"),
+		CAFile:      c.String("ca-file"),
+	}
+	for _, entry := range c.StringSlice("brokers") {
+		for _, broker := range strings.Split(entry, ",") {
+			if broker = strings.TrimSpace(broker); broker != "" {
+				cfg.Brokers = append(cfg.Brokers, broker)
+			}
+		}
+	}
+	if len(cfg.Brokers) == 0 {
+		fie(errors.New("-brokers required"))
+	}
+	topic := c.String("topic")
+	if topic == "" {
+		fie(errors.New("-topic required"))
+	}
+	var err error
+	cfg.BatchAge, err = time.ParseDuration(c.String("batch-age"))
+	fie(err)
+	cfg.SASLUser, cfg.SASLPassword = parseUser(c)
+
+	producer, err := rdstail.NewKafkaProducer(cfg)
+	fie(err)
+	feed(c, rdstail.NewKafkaSink(producer, topic))
+}
+
+func gelf(c *cli.Context) {
+	cfg := rdstail.GELFConfig{
+		Network:     c.String("network"),
+		Addr:        c.String("addr"),
+		Compression: c.String("compression"),
+		ChunkSize:   c.Int("chunk-size"),
+	}
+	if cfg.Addr == "" {
+		fie(errors.New("-addr required"))
+	}
+	sink, err := rdstail.NewGELFSink(cfg)
+	fie(err)
+	feed(c, sink)
+}
+
+func fluent(c *cli.Context) {
+	cfg := rdstail.FluentConfig{
+		Network: c.String("network"),
+		Addr:    c.String("addr"),
+		Mode:    c.String("mode"),
+		Tag:     c.String("tag"),
+		Ack:     c.Bool("ack"),
+	}
+	if cfg.Addr == "" {
+		fie(errors.New("-addr required"))
+	}
+	sink, err := rdstail.NewFluentSink(cfg)
+	fie(err)
+	feed(c, sink)
+}
+
+func otlp(c *cli.Context) {
+	sink, err := rdstail.NewOTLPSink(rdstail.OTLPConfig{
+		Protocol: c.String("protocol"),
+		URL:      c.String("url"),
+		Headers:  parseHeaders(c),
+		Gzip:     c.Bool("gzip"),
+		Region:   c.GlobalString("region"),
+	})
+	fie(err)
+	feed(c, sink)
+}
+
 func tail(c *cli.Context) {
 	r := setupRDS(c)
-	db := parseDB(c)
+	sel := parseSelector(c)
+	instances, err := sel.Select(r)
+	fie(err)
+	patterns := parsePatterns(c)
+	if len(patterns) == 0 {
+		patterns = []string{""}
+	}
 	numLines := int64(c.Int("lines"))
-	err := rdstail.Tail(r, db, numLines)
+	first := true
+	for _, inst := range instances {
+		for _, pattern := range patterns {
+			if sel.Multi() || len(patterns) > 1 {
+				if !first {
+					fmt.Println()
+				}
+				fmt.Printf("==> %s <==\n", strings.TrimSpace(inst.Label()+" "+pattern))
+			}
+			first = false
+			err := rdstail.Tail(r, inst.ID, pattern, numLines)
+			fie(err)
+		}
+	}
+}
+
+func list(c *cli.Context) {
+	r := setupRDS(c)
+	sel := parseSelector(c)
+	instances, err := sel.Select(r)
+	fie(err)
+	patterns := parsePatterns(c)
+	if len(patterns) == 0 {
+		patterns = []string{""}
+	}
+	since := parseTime(c, "since")
+	until := parseTime(c, "until")
+
+	files := []rdstail.LogFile{}
+	seen := make(map[string]bool)
+	for _, inst := range instances {
+		for _, pattern := range patterns {
+			found, err := rdstail.ListLogFiles(r, inst.ID, pattern, since, until)
+			fie(err)
+			for _, f := range found {
+				if !seen[f.Instance+" "+f.Name] {
+					seen[f.Instance+" "+f.Name] = true
+					files = append(files, f)
+				}
+			}
+		}
+	}
+	fie(rdstail.SortLogFiles(files, c.String("sort"), c.Bool("reverse")))
+
+	if c.Bool("json") {
+		fie(json.NewEncoder(os.Stdout).Encode(files))
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	multi := sel.Multi()
+	if multi {
+		fmt.Fprint(w, "INSTANCE\t")
+	}
+	fmt.Fprintln(w, "NAME\tSIZE\tLAST WRITTEN\tEPOCH")
+	for _, f := range files {
+		if multi {
+			fmt.Fprintf(w, "%s\t", f.Instance)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%d\n", f.Name, f.Size, f.LastWritten.Local().Format("2006-01-02 15:04:05 MST"), f.LastWrittenEpoch)
+	}
+	fie(w.Flush())
+}
+
+func download(c *cli.Context) {
+	r := setupRDS(c)
+	sel := parseSelector(c)
+	instances, err := sel.Select(r)
+	fie(err)
+	patterns := parsePatterns(c)
+	if len(patterns) == 0 {
+		patterns = []string{""}
+	}
+	since := parseTime(c, "since")
+	until := parseTime(c, "until")
+
+	for _, inst := range instances {
+		dir := c.String("out")
+		if sel.Multi() {
+			dir = filepath.Join(dir, inst.ID)
+		}
+		for _, pattern := range patterns {
+			err := rdstail.Download(r, inst.ID, pattern, since, until, dir, c.Bool("gzip"))
+			fie(err)
+		}
+	}
+}
+
+func archive(c *cli.Context) {
+	bucket := c.String("bucket")
+	if bucket == "" {
+		fie(errors.New("-bucket required"))
+	}
+	interval, err := time.ParseDuration(c.String("interval"))
+	fie(err)
+	settle, err := time.ParseDuration(c.String("settle"))
 	fie(err)
+
+	r := setupRDS(c)
+	store := setupS3(c)
+	sel := parseSelector(c)
+	patterns := parsePatterns(c)
+	if len(patterns) == 0 {
+		patterns = []string{""}
+	}
+
+	stop := make(chan struct{})
+	go signalListen(stop)
+
+	for {
+		instances, err := sel.Select(r)
+		if err != nil {
+			log.Printf("listing instances: %s", err)
+		}
+		for _, inst := range instances {
+			for _, pattern := range patterns {
+				err := rdstail.Archive(r, store, inst.ID, pattern, bucket, c.String("prefix"), settle)
+				if err != nil {
+					log.Printf("%s: archiving: %s", inst.ID, err)
+				}
+			}
+		}
+		if interval <= 0 {
+			return
+		}
+		select {
+		case <-time.After(interval):
+		case <-stop:
+			return
+		}
+	}
 }
 
 func main() {
@@ -111,9 +640,26 @@ func main() {
     AWS credentials are taken from an ~/.aws/credentials file or the env vars AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.`
 	app.Version = "0.1.0"
 	app.Flags = []cli.Flag{
-		cli.StringFlag{
+		cli.StringSliceFlag{
 			Name:  "instance, i",
-			Usage: "name of the db instance in rds [required]",
+			Usage: "name of the db instance in rds, may be repeated or comma separated. globs (prod-*) and /regexps/ are matched against all instances [required unless -cluster, -tag or -engine is given]",
+		},
+		cli.StringSliceFlag{
+			Name:  "cluster",
+			Usage: "aurora cluster identifier, all of its members are used and tagged as writer or reader. may be repeated",
+		},
+		cli.StringSliceFlag{
+			Name:  "tag",
+			Usage: "only use instances with this tag, as key=value or just key. may be repeated",
+		},
+		cli.StringSliceFlag{
+			Name:  "engine",
+			Usage: "only use instances running this engine e.g. postgres, mysql or aurora-*. may be repeated",
+		},
+		cli.StringFlag{
+			Name:  "refresh",
+			Value: "5m",
+			Usage: "how often to look for new or removed instances and cluster failovers when watching. 0 to never",
 		},
 		cli.StringFlag{
 			Name:   "region",
@@ -133,7 +679,7 @@ func main() {
 			Name:   "papertrail",
 			Usage:  "stream logs into papertrail",
 			Action: papertrail,
-			Flags: []cli.Flag{
+			Flags: sinkFlags(
 				cli.StringFlag{
 					Name:  "papertrail, p",
 					Value: "",
@@ -150,11 +696,81 @@ func main() {
 					Usage: "hostname of the client, sent to papertrail",
 				},
 				cli.StringFlag{
-					Name:  "rate, r",
-					Value: "3s",
-					Usage: "rds log polling rate",
+					Name:  "ca-file",
+					Usage: "pem file with the certificates to verify papertrail with, instead of the system's",
 				},
-			},
+				cli.IntFlag{
+					Name:  "max-size",
+					Value: 8192,
+					Usage: "longest message to send, longer log entries are split over several",
+				},
+				cli.IntFlag{
+					Name:  "queue-size",
+					Value: 10000,
+					Usage: "how many messages to hold while reconnecting, past that the oldest are dropped",
+				},
+			),
+		},
+
+		{
+			Name:   "syslog",
+			Usage:  "stream logs to a syslog server",
+			Action: syslog,
+			Flags: sinkFlags(
+				cli.StringFlag{
+					Name:  "addr",
+					Usage: "syslog server host:port [required]",
+				},
+				cli.StringFlag{
+					Name:  "network",
+					Value: "udp",
+					Usage: "udp, tcp or tls",
+				},
+				cli.StringFlag{
+					Name:  "format",
+					Value: "rfc5424",
+					Usage: "message format, rfc5424 or rfc3164",
+				},
+				cli.StringFlag{
+					Name:  "framing",
+					Value: "octet",
+					Usage: "message framing over tcp and tls, octet counting or newline",
+				},
+				cli.StringFlag{
+					Name:  "ca-file",
+					Usage: "pem file with the certificates to verify a tls server with, instead of the system's",
+				},
+				cli.StringFlag{
+					Name:  "facility",
+					Value: "user",
+					Usage: "syslog facility, by name (local0) or number",
+				},
+				cli.StringFlag{
+					Name:  "severity",
+					Value: "info",
+					Usage: "syslog severity of lines without one of their own, by name (notice) or number",
+				},
+				cli.StringFlag{
+					Name:  "app, a",
+					Value: "rdstail",
+					Usage: "app name to send",
+				},
+				cli.StringFlag{
+					Name:  "hostname",
+					Value: "os.Hostname()",
+					Usage: "hostname of the client to send",
+				},
+				cli.IntFlag{
+					Name:  "max-size",
+					Value: 8192,
+					Usage: "longest message to send, longer log entries are split over several",
+				},
+				cli.IntFlag{
+					Name:  "queue-size",
+					Value: 10000,
+					Usage: "how many messages to hold while reconnecting, past that the oldest are dropped",
+				},
+			),
 		},
 
 		{
@@ -162,11 +778,368 @@ func main() {
 			Usage:  "stream logs to stdout",
 			Action: watch,
 			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  "file-pattern, f",
+					Usage: "only follow log files matching this glob (error/*) or /regexp/. may be repeated, each pattern is followed on its own",
+				},
+				cli.StringFlag{
+					Name:  "log-type",
+					Usage: "only follow this mysql log: error, slow or general",
+				},
 				cli.StringFlag{
 					Name:  "rate, r",
 					Value: "3s",
 					Usage: "rds log polling rate",
 				},
+				cli.StringFlag{
+					Name:  "state-file",
+					Usage: "file to save the read position in, so a restart resumes where it left off",
+				},
+			},
+		},
+
+		{
+			Name:   "cloudwatch",
+			Usage:  "stream parsed logs into cloudwatch logs",
+			Action: cloudwatch,
+			Flags: sinkFlags(
+				cli.StringFlag{
+					Name:  "group",
+					Usage: "log group to put the logs in, created if missing [required]",
+				},
+				cli.StringFlag{
+					Name:  "stream",
+					Value: "{instance}",
+					Usage: "log stream to put the logs in. {instance}, {engine}, {cluster}, {role}, {logfile} and {logtype} are filled in for each line",
+				},
+				cli.StringFlag{
+					Name:  "endpoint",
+					Usage: "cloudwatch logs endpoint to use instead of the region's, e.g. http://localhost:4566",
+				},
+			),
+		},
+
+		{
+			Name:   "http",
+			Usage:  "post parsed logs to an http endpoint as json",
+			Action: httpPost,
+			Flags: sinkFlags(
+				cli.StringFlag{
+					Name:  "url",
+					Usage: "url to post the logs to [required]",
+				},
+				cli.StringFlag{
+					Name:  "format",
+					Value: "json",
+					Usage: "json for an array of events per request, or ndjson for one event per line",
+				},
+				cli.StringSliceFlag{
+					Name:  "header, H",
+					Usage: "header to send with every request e.g. \"Authorization: Bearer token\". may be repeated",
+				},
+				cli.BoolFlag{
+					Name:  "gzip, z",
+					Usage: "gzip the requests",
+				},
+				cli.IntFlag{
+					Name:  "batch-size",
+					Value: 500,
+					Usage: "most events to send in one request",
+				},
+			),
+		},
+
+		{
+			Name:    "elasticsearch",
+			Aliases: []string{"es"},
+			Usage:   "index parsed logs in elasticsearch or opensearch",
+			Action:  elasticsearch,
+			Flags: sinkFlags(
+				cli.StringFlag{
+					Name:  "url",
+					Value: "http://localhost:9200",
+					Usage: "url of the cluster",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "rds-{instance}-{yyyy.MM.dd}",
+					Usage: "index to put the logs in. {instance}, {engine}, {cluster}, {role}, {logfile}, {logtype} and dates like {yyyy.MM.dd} are filled in for each line",
+				},
+				cli.StringFlag{
+					Name:  "user, u",
+					Usage: "user:password to authenticate with",
+				},
+				cli.StringSliceFlag{
+					Name:  "header, H",
+					Usage: "header to send with every request e.g. \"Authorization: ApiKey key\". may be repeated",
+				},
+				cli.BoolFlag{
+					Name:  "gzip, z",
+					Usage: "gzip the requests",
+				},
+				cli.IntFlag{
+					Name:  "batch-size",
+					Value: 500,
+					Usage: "most events to send in one _bulk request",
+				},
+			),
+		},
+
+		{
+			Name:   "loki",
+			Usage:  "push parsed logs to grafana loki",
+			Action: loki,
+			Flags: sinkFlags(
+				cli.StringFlag{
+					Name:  "url",
+					Value: "http://localhost:3100",
+					Usage: "url of the loki server",
+				},
+				cli.StringFlag{
+					Name:  "format",
+					Value: "protobuf",
+					Usage: "push snappy compressed protobuf or json",
+				},
+				cli.StringSliceFlag{
+					Name:  "label, l",
+					Usage: "label to add to every stream, as name=value. may be repeated",
+				},
+				cli.StringFlag{
+					Name:  "user, u",
+					Usage: "user:password to authenticate with",
+				},
+				cli.StringSliceFlag{
+					Name:  "header, H",
+					Usage: "header to send with every request e.g. \"X-Scope-OrgID: tenant\". may be repeated",
+				},
+			),
+		},
+
+		{
+			Name:   "kafka",
+			Usage:  "publish parsed logs to kafka, keyed by instance",
+			Action: kafka,
+			Flags: sinkFlags(
+				cli.StringSliceFlag{
+					Name:  "brokers, b",
+					Usage: "kafka brokers as host:port, comma separated or repeated [required]",
+				},
+				cli.StringFlag{
+					Name:  "topic, t",
+					Usage: "topic to publish to. {instance}, {engine}, {cluster}, {role}, {logfile} and {logtype} are filled in for each line [required]",
+				},
+				cli.StringFlag{
+					Name:  "compression",
+					Value: "none",
+					Usage: "none, gzip, snappy, lz4 or zstd",
+				},
+				cli.StringFlag{
+					Name:  "acks",
+					Value: "all",
+					Usage: "acknowledgements to wait for: none, leader or all",
+				},
+				cli.IntFlag{
+					Name:  "batch-size",
+					Value: 500,
+					Usage: "messages to gather before sending a batch",
+				},
+				cli.StringFlag{
+					Name:  "batch-age",
+					Value: "100ms",
+					Usage: "longest to gather messages before sending a batch",
+				},
+				cli.BoolFlag{
+					Name:  "tls",
+					Usage: "connect to the brokers over tls",
+				},
+				cli.StringFlag{
+					Name:  "ca-file",
+					Usage: "pem file with the certificates to verify the brokers with, instead of the system's",
+				},
+				cli.StringFlag{
+					Name:  "user, u",
+					Usage: "user:password to authenticate with over sasl/plain",
+				},
+			),
+		},
+
+		{
+			Name:   "gelf",
+			Usage:  "send parsed logs to graylog as gelf",
+			Action: gelf,
+			Flags: sinkFlags(
+				cli.StringFlag{
+					Name:  "addr",
+					Usage: "graylog gelf input host:port [required]",
+				},
+				cli.StringFlag{
+					Name:  "network",
+					Value: "udp",
+					Usage: "udp or tcp",
+				},
+				cli.StringFlag{
+					Name:  "compression",
+					Value: "gzip",
+					Usage: "compression of udp messages: gzip, zlib or none",
+				},
+				cli.IntFlag{
+					Name:  "chunk-size",
+					Value: 1420,
+					Usage: "biggest udp datagram to send, bigger messages are chunked",
+				},
+			),
+		},
+
+		{
+			Name:   "fluent",
+			Usage:  "forward parsed logs to fluentd or fluent bit",
+			Action: fluent,
+			Flags: sinkFlags(
+				cli.StringFlag{
+					Name:  "addr",
+					Usage: "forward input host:port, or the path of its unix socket [required]",
+				},
+				cli.StringFlag{
+					Name:  "network",
+					Value: "tcp",
+					Usage: "tcp or unix",
+				},
+				cli.StringFlag{
+					Name:  "mode",
+					Value: "forward",
+					Usage: "forward or packed, for packedforward mode",
+				},
+				cli.StringFlag{
+					Name:  "tag",
+					Value: "rds.{instance}.{logtype}",
+					Usage: "tag of each line. {instance}, {engine}, {cluster}, {role}, {logfile} and {logtype} are filled in",
+				},
+				cli.BoolFlag{
+					Name:  "ack",
+					Usage: "wait for the server to acknowledge every message",
+				},
+			),
+		},
+
+		{
+			Name:   "otlp",
+			Usage:  "export parsed logs to an opentelemetry collector",
+			Action: otlp,
+			Flags: sinkFlags(
+				cli.StringFlag{
+					Name:  "protocol",
+					Value: "http",
+					Usage: "http for otlp/http with protobuf, or grpc",
+				},
+				cli.StringFlag{
+					Name:  "url",
+					Usage: "url of the collector, https for tls. defaults to http://localhost:4318 for http and http://localhost:4317 for grpc",
+				},
+				cli.StringSliceFlag{
+					Name:  "header, H",
+					Usage: "header to send with every request e.g. \"Authorization: Bearer token\". may be repeated",
+				},
+				cli.BoolFlag{
+					Name:  "gzip, z",
+					Usage: "gzip requests",
+				},
+			),
+		},
+
+		{
+			Name:    "list",
+			Aliases: []string{"ls"},
+			Usage:   "list log files with their size and last written time",
+			Action:  list,
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  "file-pattern, pattern, f",
+					Usage: "only list log files matching this glob (error/*) or /regexp/. may be repeated",
+				},
+				cli.StringFlag{
+					Name:  "since",
+					Usage: "only list files written since this long ago (2h) or this time (2006-01-02T15:04:05Z)",
+				},
+				cli.StringFlag{
+					Name:  "until",
+					Usage: "only list files written before this long ago (30m) or this time (2006-01-02T15:04:05Z), the one being written then included",
+				},
+				cli.StringFlag{
+					Name:  "sort, s",
+					Value: "name",
+					Usage: "sort by name, size or time",
+				},
+				cli.BoolFlag{
+					Name:  "reverse",
+					Usage: "reverse the sort order",
+				},
+				cli.BoolFlag{
+					Name:  "json",
+					Usage: "print the list as json",
+				},
+			},
+		},
+
+		{
+			Name:   "download",
+			Usage:  "download whole log files to disk",
+			Action: download,
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  "file-pattern, f",
+					Usage: "only download log files matching this glob (error/*) or /regexp/. may be repeated",
+				},
+				cli.StringFlag{
+					Name:  "since",
+					Usage: "only download files written since this long ago (2h) or this time (2006-01-02T15:04:05Z)",
+				},
+				cli.StringFlag{
+					Name:  "until",
+					Usage: "only download files written before this long ago (30m) or this time (2006-01-02T15:04:05Z), the one being written then included",
+				},
+				cli.StringFlag{
+					Name:  "out, o",
+					Value: ".",
+					Usage: "directory to save the files in, under their rds names. with several instances each gets a directory of its own",
+				},
+				cli.BoolFlag{
+					Name:  "gzip, z",
+					Usage: "gzip the downloaded files",
+				},
+			},
+		},
+
+		{
+			Name:   "archive",
+			Usage:  "upload finished log files to s3, gzipped",
+			Action: archive,
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  "file-pattern, f",
+					Usage: "only archive log files matching this glob (error/*) or /regexp/. may be repeated",
+				},
+				cli.StringFlag{
+					Name:  "bucket, b",
+					Usage: "s3 bucket to upload to [required]",
+				},
+				cli.StringFlag{
+					Name:  "prefix",
+					Usage: "key prefix, files go under prefix/instance/name.gz",
+				},
+				cli.StringFlag{
+					Name:  "interval",
+					Value: "10m",
+					Usage: "how often to look for files to archive. 0 to archive once and exit",
+				},
+				cli.StringFlag{
+					Name:  "settle",
+					Value: "1h",
+					Usage: "how long a file must go unwritten to be considered finished",
+				},
+				cli.StringFlag{
+					Name:  "endpoint",
+					Usage: "s3 endpoint url, for s3 compatible servers like minio",
+				},
 			},
 		},
 
@@ -175,6 +1148,10 @@ func main() {
 			Usage:  "tail the last N lines",
 			Action: tail,
 			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  "file-pattern, f",
+					Usage: "only tail log files matching this glob (error/*) or /regexp/. may be repeated, each pattern is tailed on its own",
+				},
 				cli.IntFlag{
 					Name:  "lines, n",
 					Value: 20,
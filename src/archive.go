@@ -0,0 +1,155 @@
+package rdstail
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// The metadata an archived log file is stored with, to tell whether it needs
+// uploading again
+const (
+	archiveMetaSize        = "Rds-Size"
+	archiveMetaLastWritten = "Rds-Last-Written"
+	archiveMetaSHA256      = "Rds-Sha256"
+)
+
+// ArchiveStore is the part of the S3 API Archive uses, implemented by
+// *s3.S3.
+type ArchiveStore interface {
+	HeadObject(*s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
+	PutObject(*s3.PutObjectInput) (*s3.PutObjectOutput, error)
+	CopyObject(*s3.CopyObjectInput) (*s3.CopyObjectOutput, error)
+}
+
+// ArchiveKey is where a log file of db is kept in the archive, e.g.
+// prefix/prod-db/error/postgresql.log.2015-03-13-05.gz.  It goes by the name
+// alone, so a file written to again later replaces its archived copy.
+func ArchiveKey(prefix, db, name string) string {
+	return path.Join(prefix, db, name) + ".gz"
+}
+
+// Archive uploads the log files of db matching pattern that haven't been
+// written to for settle to bucket, gzipped.  A file already archived with the
+// same size and last written time is skipped without downloading it, and one
+// whose content turns out the same is not uploaded again.
+func Archive(r LogSource, store ArchiveStore, db, pattern, bucket, prefix string, settle time.Duration) error {
+	files, err := ListLogFiles(r, db, pattern, time.Time{}, time.Time{})
+	if err != nil {
+		return err
+	}
+	settled := time.Now().Add(-settle)
+	for _, f := range files {
+		if f.LastWritten.After(settled) {
+			continue
+		}
+		key := ArchiveKey(prefix, db, f.Name)
+		meta, err := archivedMetadata(store, bucket, key)
+		if err != nil {
+			return err
+		}
+		size := strconv.FormatInt(f.Size, 10)
+		lastWritten := strconv.FormatInt(f.LastWrittenEpoch, 10)
+		if meta[archiveMetaSize] == size && meta[archiveMetaLastWritten] == lastWritten {
+			continue
+		}
+
+		n, err := archiveLogFile(r, store, db, f, bucket, key, meta[archiveMetaSHA256])
+		if err != nil {
+			return err
+		}
+		if n >= 0 {
+			log.Printf("%s: %s archived to s3://%s/%s, %d bytes", db, f.Name, bucket, key, n)
+		}
+	}
+	return nil
+}
+
+// archivedMetadata returns the metadata of the object at key, or nil if there
+// is none.
+func archivedMetadata(store ArchiveStore, bucket, key string) (map[string]string, error) {
+	out, err := store.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() == 404 {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	meta := make(map[string]string)
+	for k, v := range out.Metadata {
+		meta[k] = aws.StringValue(v)
+	}
+	return meta, nil
+}
+
+// archiveLogFile downloads a log file into a gzipped temporary file and
+// uploads it.  If its content hashes to archivedSHA256 only the metadata of
+// the archived copy is brought up to date.  It returns the size of the
+// upload, or -1 if there was none.
+func archiveLogFile(r LogSource, store ArchiveStore, db string, f LogFile, bucket, key, archivedSHA256 string) (int64, error) {
+	tmp, err := ioutil.TempFile("", "rdstail-archive")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	gz := gzip.NewWriter(tmp)
+	if _, err := copyCompleteLogFile(r, db, f.Name, io.MultiWriter(gz, hash)); err != nil {
+		return 0, err
+	}
+	if err := gz.Close(); err != nil {
+		return 0, err
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+
+	meta := map[string]*string{
+		archiveMetaSize:        aws.String(strconv.FormatInt(f.Size, 10)),
+		archiveMetaLastWritten: aws.String(strconv.FormatInt(f.LastWrittenEpoch, 10)),
+		archiveMetaSHA256:      aws.String(sum),
+	}
+	if sum == archivedSHA256 {
+		_, err := store.CopyObject(&s3.CopyObjectInput{
+			Bucket:            aws.String(bucket),
+			Key:               aws.String(key),
+			CopySource:        aws.String(url.PathEscape(bucket) + "/" + (&url.URL{Path: key}).EscapedPath()),
+			ContentType:       aws.String("text/plain"),
+			ContentEncoding:   aws.String("gzip"),
+			Metadata:          meta,
+			MetadataDirective: aws.String(s3.MetadataDirectiveReplace),
+		})
+		return -1, err
+	}
+
+	size, err := tmp.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	_, err = store.PutObject(&s3.PutObjectInput{
+		Bucket:          aws.String(bucket),
+		Key:             aws.String(key),
+		Body:            tmp,
+		ContentType:     aws.String("text/plain"),
+		ContentEncoding: aws.String("gzip"),
+		Metadata:        meta,
+	})
+	return size, err
+}
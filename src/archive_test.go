@@ -0,0 +1,143 @@
+package rdstail_test
+
+import (
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/rdstailtest"
+)
+
+type s3Object struct {
+	body []byte
+	meta map[string]string
+}
+
+// memoryBucket is an rdstail.ArchiveStore holding a single bucket in memory.
+type memoryBucket struct {
+	mu      sync.Mutex
+	objects map[string]s3Object
+	puts    int
+	copies  int
+}
+
+func newMemoryBucket() *memoryBucket {
+	return &memoryBucket{objects: make(map[string]s3Object)}
+}
+
+func (b *memoryBucket) HeadObject(in *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	obj, ok := b.objects[aws.StringValue(in.Key)]
+	if !ok {
+		return nil, awserr.NewRequestFailure(awserr.New("NotFound", "Not Found", nil), 404, "")
+	}
+	return &s3.HeadObjectOutput{Metadata: aws.StringMap(obj.meta)}, nil
+}
+
+func (b *memoryBucket) PutObject(in *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	body, err := ioutil.ReadAll(in.Body)
+	if err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.objects[aws.StringValue(in.Key)] = s3Object{body: body, meta: aws.StringValueMap(in.Metadata)}
+	b.puts++
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (b *memoryBucket) CopyObject(in *s3.CopyObjectInput) (*s3.CopyObjectOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	key := aws.StringValue(in.Key)
+	if src := aws.StringValue(in.Bucket) + "/" + key; aws.StringValue(in.CopySource) != src {
+		return nil, awserr.New("InvalidRequest", "unexpected copy source "+aws.StringValue(in.CopySource), nil)
+	}
+	obj, ok := b.objects[key]
+	if !ok {
+		return nil, awserr.NewRequestFailure(awserr.New("NoSuchKey", "Not Found", nil), 404, "")
+	}
+	b.objects[key] = s3Object{body: obj.body, meta: aws.StringValueMap(in.Metadata)}
+	b.copies++
+	return &s3.CopyObjectOutput{}, nil
+}
+
+// countingSource counts the complete downloads of its log files.
+type countingSource struct {
+	*rdstailtest.LogSource
+	mu        sync.Mutex
+	downloads int
+}
+
+func (s *countingSource) DownloadCompleteLogFile(db, name string) (io.ReadCloser, error) {
+	s.mu.Lock()
+	s.downloads++
+	s.mu.Unlock()
+	return s.LogSource.DownloadCompleteLogFile(db, name)
+}
+
+// checkArchive archives the files of testDB and checks how many were
+// downloaded, uploaded and had only their metadata updated.
+func checkArchive(t *testing.T, src *countingSource, bucket *memoryBucket, downloads, puts, copies int) {
+	t.Helper()
+	// Files only count as finished once they are behind the settle time
+	time.Sleep(2 * time.Millisecond)
+	if err := rdstail.Archive(src, bucket, testDB, "", "logs", "archive", time.Millisecond); err != nil {
+		t.Fatalf("Archive: %s", err)
+	}
+	if src.downloads != downloads || bucket.puts != puts || bucket.copies != copies {
+		t.Errorf("got %d downloads, %d puts and %d copies, want %d, %d and %d",
+			src.downloads, bucket.puts, bucket.copies, downloads, puts, copies)
+	}
+}
+
+func checkArchived(t *testing.T, bucket *memoryBucket, key, want string) {
+	t.Helper()
+	if len(bucket.objects) != 1 {
+		t.Errorf("bucket holds %d objects, want 1", len(bucket.objects))
+	}
+	obj, ok := bucket.objects[key]
+	if !ok {
+		t.Fatalf("nothing archived at %s", key)
+	}
+	if got := string(gunzipped(t, obj.body)); got != want {
+		t.Errorf("archived %q, want %q", got, want)
+	}
+}
+
+func TestArchiveSkipsWhatIsArchived(t *testing.T) {
+	src := &countingSource{LogSource: rdstailtest.NewLogSource()}
+	bucket := newMemoryBucket()
+	const name = "error/postgresql.log.2015-03-13-23"
+	key := rdstail.ArchiveKey("archive", testDB, name)
+	if key != "archive/db/error/postgresql.log.2015-03-13-23.gz" {
+		t.Fatalf("ArchiveKey is %q", key)
+	}
+
+	src.Append(testDB, name, "one\n")
+	checkArchive(t, src, bucket, 1, 1, 0)
+	checkArchived(t, bucket, key, "one\n")
+
+	// The same size and last written time go by the metadata alone
+	checkArchive(t, src, bucket, 1, 1, 0)
+
+	// A file written to again without changing keeps its archived copy, with
+	// the metadata brought up to date so it isn't downloaded next time
+	src.Append(testDB, name, "")
+	checkArchive(t, src, bucket, 2, 1, 1)
+	checkArchive(t, src, bucket, 2, 1, 1)
+	checkArchived(t, bucket, key, "one\n")
+
+	// A file written to after it was archived, say past midnight, replaces
+	// its archived copy
+	src.Append(testDB, name, "two\n")
+	checkArchive(t, src, bucket, 3, 2, 1)
+	checkArchived(t, bucket, key, "one\ntwo\n")
+}
@@ -0,0 +1,115 @@
+package rdstail
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+// Checkpoint records how far Watch has read, so that a restart can pick up
+// where it left off.
+type Checkpoint struct {
+	LogFileName string `json:"log_file_name"`
+	LastWritten int64  `json:"last_written"`
+	Marker      string `json:"marker"`
+}
+
+// checkpointKey is the key Watch saves its checkpoint under.  Plain db names
+// are kept for watches of every file, so older state files still resume.
+func checkpointKey(db, pattern string) string {
+	if pattern == "" {
+		return db
+	}
+	return db + " " + pattern
+}
+
+// CheckpointStore saves and restores checkpoints by key.
+type CheckpointStore interface {
+	// Load returns the checkpoint saved under key, or nil if there is none.
+	Load(key string) (*Checkpoint, error)
+	Save(key string, cp Checkpoint) error
+}
+
+// FileCheckpointStore keeps every checkpoint in a single JSON file, which is
+// rewritten atomically on each save, so a crash mid-write never leaves a
+// truncated state file behind.
+type FileCheckpointStore struct {
+	path        string
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint
+}
+
+// NewFileCheckpointStore opens the state file at path.  A missing file is
+// treated as empty.
+func NewFileCheckpointStore(path string) (*FileCheckpointStore, error) {
+	s := &FileCheckpointStore{
+		path:        path,
+		checkpoints: make(map[string]Checkpoint),
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return s, nil
+	}
+	if err := json.Unmarshal(data, &s.checkpoints); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileCheckpointStore) Load(key string) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cp, ok := s.checkpoints[key]
+	if !ok {
+		return nil, nil
+	}
+	return &cp, nil
+}
+
+func (s *FileCheckpointStore) Save(key string, cp Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[key] = cp
+
+	data, err := json.MarshalIndent(s.checkpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(s.path, data)
+}
+
+// memoryCheckpointStore keeps checkpoints for the life of the process only.
+type memoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint
+}
+
+func newMemoryCheckpointStore() *memoryCheckpointStore {
+	return &memoryCheckpointStore{checkpoints: make(map[string]Checkpoint)}
+}
+
+func (s *memoryCheckpointStore) Load(key string) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cp, ok := s.checkpoints[key]
+	if !ok {
+		return nil, nil
+	}
+	return &cp, nil
+}
+
+func (s *memoryCheckpointStore) Save(key string, cp Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[key] = cp
+	return nil
+}
@@ -0,0 +1,120 @@
+package rdstail_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/litl/rdstail/src"
+)
+
+func tempStateFile(t *testing.T) (path string, cleanup func()) {
+	dir, err := ioutil.TempDir("", "rdstail-state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(dir, "state.json"), func() { os.RemoveAll(dir) }
+}
+
+func openFileStore(t *testing.T, path string) *rdstail.FileCheckpointStore {
+	t.Helper()
+	store, err := rdstail.NewFileCheckpointStore(path)
+	if err != nil {
+		t.Fatalf("NewFileCheckpointStore: %s", err)
+	}
+	return store
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	path, cleanup := tempStateFile(t)
+	defer cleanup()
+
+	// A missing or empty state file is a fresh start
+	store := openFileStore(t, path)
+	if cp, err := store.Load(testDB); cp != nil || err != nil {
+		t.Fatalf("Load from a missing file = %v, %v", cp, err)
+	}
+	if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	store = openFileStore(t, path)
+
+	saved := map[string]rdstail.Checkpoint{
+		"db":         {LogFileName: "error/postgresql.log.00", LastWritten: 1, Marker: "10"},
+		"db error/*": {LogFileName: "error/postgresql.log.01", LastWritten: 2, Marker: "20"},
+	}
+	for key, cp := range saved {
+		if err := store.Save(key, cp); err != nil {
+			t.Fatalf("Save: %s", err)
+		}
+	}
+
+	// Each save replaces the whole file, leaving nothing else behind
+	names, err := filepath.Glob(filepath.Join(filepath.Dir(path), "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 || names[0] != path {
+		t.Errorf("state directory holds %q, want only the state file", names)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var onDisk map[string]rdstail.Checkpoint
+	if err := json.Unmarshal(data, &onDisk); err != nil || len(onDisk) != len(saved) {
+		t.Errorf("state file holds %s, want both checkpoints", data)
+	}
+
+	store = openFileStore(t, path)
+	for key, want := range saved {
+		cp, err := store.Load(key)
+		if err != nil || cp == nil || *cp != want {
+			t.Errorf("Load(%q) after reopening = %v, %v, want %v", key, cp, err, want)
+		}
+	}
+
+	if err := ioutil.WriteFile(path, data[:len(data)/2], 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rdstail.NewFileCheckpointStore(path); err == nil {
+		t.Errorf("opened a truncated state file")
+	}
+}
+
+func TestWatchResumesFromStateFile(t *testing.T) {
+	path, cleanup := tempStateFile(t)
+	defer cleanup()
+	src := newTestSource()
+	src.Append(testDB, "error/postgresql.log.00", "start\n")
+	src.Append(testDB, "slowquery/postgresql.log.00", "start\n")
+
+	// Watches of different files of one instance keep checkpoints of their
+	// own in the one state file
+	store := openFileStore(t, path)
+	for _, pattern := range []string{"error/*", "slowquery/*"} {
+		started := atomic.LoadInt32(&src.downloads)
+		w := startPatternWatch(t, src, pattern, store)
+		waitUntil(t, "watch to start", func() bool { return atomic.LoadInt32(&src.downloads) > started })
+		src.Append(testDB, strings.TrimSuffix(pattern, "*")+"postgresql.log.00", "seen\n")
+		w.waitOutput(t, "seen\n")
+		w.finish(t)
+	}
+
+	src.Append(testDB, "error/postgresql.log.00", "missed error\n")
+	src.Append(testDB, "slowquery/postgresql.log.00", "missed slow query\n")
+	src.Append(testDB, "slowquery/postgresql.log.01", "rotated slow query\n")
+
+	// A restart reads the checkpoints back and goes on from them
+	store = openFileStore(t, path)
+	w := startPatternWatch(t, src, "error/*", store)
+	w.waitOutput(t, "missed error\n")
+	w.finish(t)
+	w = startPatternWatch(t, src, "slowquery/*", store)
+	w.waitOutput(t, "missed slow query\nrotated slow query\n")
+	w.finish(t)
+}
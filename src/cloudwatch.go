@@ -0,0 +1,221 @@
+package rdstail
+
+import (
+	"log"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/chrismrivera/backoff"
+)
+
+const (
+	cloudWatchBackoffMaxWait  = time.Minute
+	cloudWatchBackoffDeadline = time.Minute * 5
+
+	// Limits of a PutLogEvents request
+	cloudWatchMaxEvents     = 10000
+	cloudWatchMaxBatchSize  = 1048576
+	cloudWatchMaxBatchSpan  = 24 * time.Hour
+	cloudWatchEventOverhead = 26
+	cloudWatchMaxEventSize  = 256*1024 - cloudWatchEventOverhead
+)
+
+// CloudWatchClient is the part of the CloudWatch Logs API the CloudWatch
+// sink uses, implemented by *cloudwatchlogs.CloudWatchLogs.
+type CloudWatchClient interface {
+	PutLogEvents(*cloudwatchlogs.PutLogEventsInput) (*cloudwatchlogs.PutLogEventsOutput, error)
+	DescribeLogStreams(*cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
+	CreateLogStream(*cloudwatchlogs.CreateLogStreamInput) (*cloudwatchlogs.CreateLogStreamOutput, error)
+	CreateLogGroup(*cloudwatchlogs.CreateLogGroupInput) (*cloudwatchlogs.CreateLogGroupOutput, error)
+}
+
+// CloudWatchSink puts events into a CloudWatch Logs group, in the stream
+// named by expanding a template like {instance} for each event.  Missing
+// streams, and the group, are created on the way.
+type CloudWatchSink struct {
+	client CloudWatchClient
+	group  string
+	stream string
+	tokens map[string]*string
+	last   map[string]time.Time
+}
+
+func NewCloudWatchSink(client CloudWatchClient, group, stream string) *CloudWatchSink {
+	return &CloudWatchSink{
+		client: client,
+		group:  group,
+		stream: stream,
+		tokens: make(map[string]*string),
+		last:   make(map[string]time.Time),
+	}
+}
+
+func (s *CloudWatchSink) Send(events []Event) error {
+	var streams []string
+	byStream := make(map[string][]*cloudwatchlogs.InputLogEvent)
+	for i := range events {
+		e := &events[i]
+		msg := e.Raw
+		if msg == "" {
+			continue
+		}
+		if len(msg) > cloudWatchMaxEventSize {
+			msg = truncateUTF8(msg, cloudWatchMaxEventSize)
+		}
+
+		stream := ExpandTemplate(s.stream, e)
+		if _, ok := byStream[stream]; !ok {
+			streams = append(streams, stream)
+		}
+		// Lines without a time of their own go with the one before them
+		last, ok := s.last[stream]
+		if !ok {
+			last = time.Now()
+		}
+		t := e.Timestamp(last)
+		s.last[stream] = t
+
+		byStream[stream] = append(byStream[stream], &cloudwatchlogs.InputLogEvent{
+			Message:   aws.String(msg),
+			Timestamp: aws.Int64(epochMillis(t)),
+		})
+	}
+
+	for _, stream := range streams {
+		for _, batch := range cloudWatchBatches(byStream[stream]) {
+			if err := s.put(stream, batch); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *CloudWatchSink) Close() error {
+	return nil
+}
+
+// cloudWatchBatches sorts events by time, as PutLogEvents wants them, and
+// splits them up to stay within its limits.
+func cloudWatchBatches(events []*cloudwatchlogs.InputLogEvent) [][]*cloudwatchlogs.InputLogEvent {
+	sort.Stable(byTimestamp(events))
+
+	var batches [][]*cloudwatchlogs.InputLogEvent
+	start, size := 0, 0
+	for i, e := range events {
+		n := len(*e.Message) + cloudWatchEventOverhead
+		span := time.Duration(*e.Timestamp-*events[start].Timestamp) * time.Millisecond
+		if i > start && (i-start == cloudWatchMaxEvents || size+n > cloudWatchMaxBatchSize || span >= cloudWatchMaxBatchSpan) {
+			batches = append(batches, events[start:i])
+			start, size = i, 0
+		}
+		size += n
+	}
+	if start < len(events) {
+		batches = append(batches, events[start:])
+	}
+	return batches
+}
+
+type byTimestamp []*cloudwatchlogs.InputLogEvent
+
+func (s byTimestamp) Len() int           { return len(s) }
+func (s byTimestamp) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byTimestamp) Less(i, j int) bool { return *s[i].Timestamp < *s[j].Timestamp }
+
+func (s *CloudWatchSink) put(stream string, batch []*cloudwatchlogs.InputLogEvent) error {
+	return backoff.Try(cloudWatchBackoffMaxWait, cloudWatchBackoffDeadline, func() error {
+		out, err := s.client.PutLogEvents(&cloudwatchlogs.PutLogEventsInput{
+			LogGroupName:  aws.String(s.group),
+			LogStreamName: aws.String(stream),
+			LogEvents:     batch,
+			SequenceToken: s.tokens[stream],
+		})
+		if err == nil {
+			s.tokens[stream] = out.NextSequenceToken
+			if info := out.RejectedLogEventsInfo; info != nil {
+				log.Printf("cloudwatch: %s: some events were rejected for being too old or too new: %s", stream, info)
+			}
+			return nil
+		}
+
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			return err
+		}
+		switch aerr.Code() {
+		case "ResourceNotFoundException":
+			if err := s.createStream(stream); err != nil {
+				return err
+			}
+			s.tokens[stream] = nil
+		case "InvalidSequenceTokenException":
+			if err := s.refreshToken(stream); err != nil {
+				return err
+			}
+		case "DataAlreadyAcceptedException":
+			// An earlier try got through after all
+			return s.refreshToken(stream)
+		}
+		return err
+	})
+}
+
+func (s *CloudWatchSink) createStream(stream string) error {
+	req := &cloudwatchlogs.CreateLogStreamInput{
+		LogGroupName:  aws.String(s.group),
+		LogStreamName: aws.String(stream),
+	}
+	_, err := s.client.CreateLogStream(req)
+	if isAWSError(err, "ResourceNotFoundException") {
+		_, err = s.client.CreateLogGroup(&cloudwatchlogs.CreateLogGroupInput{
+			LogGroupName: aws.String(s.group),
+		})
+		if err != nil && !isAWSError(err, "ResourceAlreadyExistsException") {
+			return err
+		}
+		_, err = s.client.CreateLogStream(req)
+	}
+	if err != nil && !isAWSError(err, "ResourceAlreadyExistsException") {
+		return err
+	}
+	return nil
+}
+
+// refreshToken looks up the sequence token the stream expects next.
+func (s *CloudWatchSink) refreshToken(stream string) error {
+	out, err := s.client.DescribeLogStreams(&cloudwatchlogs.DescribeLogStreamsInput{
+		LogGroupName:        aws.String(s.group),
+		LogStreamNamePrefix: aws.String(stream),
+	})
+	if err != nil {
+		return err
+	}
+	s.tokens[stream] = nil
+	for _, ls := range out.LogStreams {
+		if aws.StringValue(ls.LogStreamName) == stream {
+			s.tokens[stream] = ls.UploadSequenceToken
+		}
+	}
+	return nil
+}
+
+func isAWSError(err error, code string) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == code
+}
+
+// truncateUTF8 cuts s down to at most n bytes without splitting a character.
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,240 @@
+package rdstail_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/parser"
+)
+
+type cloudWatchEvent struct {
+	Message   string `json:"message"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// cloudWatchServer stands in for CloudWatch Logs, checking sequence tokens
+// the way it does.
+type cloudWatchServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	tokens  map[string]int // next sequence token of each stream
+	puts    map[string][][]cloudWatchEvent
+	invalid int // PutLogEvents calls rejected for their sequence token
+}
+
+func newCloudWatchServer() *cloudWatchServer {
+	s := &cloudWatchServer{tokens: make(map[string]int), puts: make(map[string][][]cloudWatchEvent)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *cloudWatchServer) handle(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		LogStreamName       string
+		LogStreamNamePrefix string
+		SequenceToken       *string
+		LogEvents           []cloudWatchEvent
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fail := func(code, msg string) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"__type":%q,"message":%q}`, code, msg)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	switch action := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "Logs_20140328."); action {
+	case "CreateLogGroup":
+		fmt.Fprint(w, `{}`)
+	case "CreateLogStream":
+		if _, ok := s.tokens[req.LogStreamName]; ok {
+			fail("ResourceAlreadyExistsException", "the specified log stream already exists")
+			return
+		}
+		s.tokens[req.LogStreamName] = 0
+		fmt.Fprint(w, `{}`)
+	case "DescribeLogStreams":
+		var streams []map[string]string
+		for name, token := range s.tokens {
+			if strings.HasPrefix(name, req.LogStreamNamePrefix) {
+				stream := map[string]string{"logStreamName": name}
+				if token > 0 {
+					stream["uploadSequenceToken"] = strconv.Itoa(token)
+				}
+				streams = append(streams, stream)
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"logStreams": streams})
+	case "PutLogEvents":
+		token, ok := s.tokens[req.LogStreamName]
+		if !ok {
+			fail("ResourceNotFoundException", "the specified log stream does not exist")
+			return
+		}
+		if (token == 0) != (req.SequenceToken == nil) || (token > 0 && *req.SequenceToken != strconv.Itoa(token)) {
+			s.invalid++
+			fail("InvalidSequenceTokenException", "the given sequenceToken is invalid")
+			return
+		}
+		s.tokens[req.LogStreamName] = token + 1
+		s.puts[req.LogStreamName] = append(s.puts[req.LogStreamName], req.LogEvents)
+		fmt.Fprintf(w, `{"nextSequenceToken":"%d"}`, token+1)
+	default:
+		http.Error(w, "unknown action "+action, http.StatusBadRequest)
+	}
+}
+
+func (s *cloudWatchServer) sink() *rdstail.CloudWatchSink {
+	cfg := aws.NewConfig().
+		WithRegion("us-east-1").
+		WithEndpoint(s.URL).
+		WithCredentials(credentials.NewStaticCredentials("id", "secret", "")).
+		WithMaxRetries(0)
+	return rdstail.NewCloudWatchSink(cloudwatchlogs.New(session.New(), cfg), "rds", "{instance}")
+}
+
+func (s *cloudWatchServer) batches(stream string) [][]cloudWatchEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.puts[stream]
+}
+
+func cloudWatchEvents(n, size int, start time.Time) []rdstail.Event {
+	events := make([]rdstail.Event, n)
+	for i := range events {
+		raw := strconv.Itoa(i) + " "
+		raw += strings.Repeat("x", size-len(raw))
+		events[i] = rdstail.Event{
+			Instance: rdstail.Instance{ID: testDB},
+			LogFile:  "error/postgresql.log.00",
+			Event:    parser.Event{Time: start.Add(time.Duration(i) * time.Millisecond), Raw: raw},
+		}
+	}
+	return events
+}
+
+// checkBatches checks the events got through whole and in order, in batches
+// of the given sizes, each within the limits of a PutLogEvents request.
+func checkBatches(t *testing.T, batches [][]cloudWatchEvent, events []rdstail.Event, sizes ...int) {
+	t.Helper()
+	if len(batches) != len(sizes) {
+		t.Fatalf("got %d batches, want %d", len(batches), len(sizes))
+	}
+	i := 0
+	for b, batch := range batches {
+		if len(batch) != sizes[b] {
+			t.Errorf("batch %d has %d events, want %d", b, len(batch), sizes[b])
+		}
+		bytes := 0
+		for _, e := range batch {
+			bytes += len(e.Message) + 26
+			if i < len(events) && e.Message != events[i].Raw {
+				t.Fatalf("event %d out of order or mangled", i)
+			}
+			i++
+		}
+		if len(batch) > 10000 || bytes > 1048576 {
+			t.Errorf("batch %d of %d events and %d bytes is over the limits", b, len(batch), bytes)
+		}
+	}
+}
+
+func TestCloudWatchSplitsAtEventCount(t *testing.T) {
+	srv := newCloudWatchServer()
+	defer srv.Close()
+
+	events := cloudWatchEvents(10001, 10, time.Now())
+	if err := srv.sink().Send(events); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+	checkBatches(t, srv.batches(testDB), events, 10000, 1)
+}
+
+func TestCloudWatchSplitsAtSize(t *testing.T) {
+	srv := newCloudWatchServer()
+	defer srv.Close()
+
+	// Five of these fit in a megabyte, the sixth doesn't
+	events := cloudWatchEvents(12, 200000, time.Now())
+	if err := srv.sink().Send(events); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+	checkBatches(t, srv.batches(testDB), events, 5, 5, 2)
+}
+
+func TestCloudWatchInvalidSequenceToken(t *testing.T) {
+	srv := newCloudWatchServer()
+	defer srv.Close()
+
+	first := srv.sink()
+	if err := first.Send(cloudWatchEvents(1, 10, time.Now())); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+
+	// Another writer to the stream has moved its sequence token on
+	second := srv.sink()
+	if err := second.Send(cloudWatchEvents(2, 10, time.Now())); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+	if err := first.Send(cloudWatchEvents(3, 10, time.Now())); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+
+	srv.mu.Lock()
+	invalid := srv.invalid
+	srv.mu.Unlock()
+	if invalid != 2 {
+		t.Errorf("got %d sequence token rejections, want 2", invalid)
+	}
+	var sizes []int
+	for _, batch := range srv.batches(testDB) {
+		sizes = append(sizes, len(batch))
+	}
+	if fmt.Sprint(sizes) != "[1 2 3]" {
+		t.Errorf("got batches of %v events, want [1 2 3]", sizes)
+	}
+}
+
+func TestCloudWatchTimestamps(t *testing.T) {
+	srv := newCloudWatchServer()
+	defer srv.Close()
+
+	start := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	events := []rdstail.Event{
+		{Instance: rdstail.Instance{ID: testDB}, Event: parser.Event{Time: start.Add(2 * time.Second), Raw: "second"}},
+		{Instance: rdstail.Instance{ID: testDB}, Event: parser.Event{Time: start, Raw: "first"}},
+		// Without a time of its own, it goes with the line before
+		{Instance: rdstail.Instance{ID: testDB}, Event: parser.Event{Raw: "untimed"}},
+	}
+	if err := srv.sink().Send(events); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+
+	batches := srv.batches(testDB)
+	if len(batches) != 1 {
+		t.Fatalf("got %d batches, want 1", len(batches))
+	}
+	ms := start.UnixNano() / int64(time.Millisecond)
+	want := []cloudWatchEvent{{"first", ms}, {"untimed", ms}, {"second", ms + 2000}}
+	if fmt.Sprint(batches[0]) != fmt.Sprint(want) {
+		t.Errorf("put %v, want %v sorted by time", batches[0], want)
+	}
+}
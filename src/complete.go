@@ -0,0 +1,218 @@
+package rdstail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// TruncationMarker is what RDS puts in place of whatever didn't fit into a
+// DownloadDBLogFilePortion response.
+const TruncationMarker = "[Your log message was truncated]"
+
+// endsTruncated reports whether a log file portion was cut short, the marker
+// ending it.  The same text anywhere else is just part of a line.
+func endsTruncated(data string) bool {
+	return strings.HasSuffix(strings.TrimRight(data, "\r\n"), TruncationMarker)
+}
+
+const (
+	// overlapLines is how many lines are tailed after a complete download to
+	// find the end marker and bridge anything written in the meantime.
+	overlapLines = 50
+	// overlapKeep is how much of the end of a complete download is kept in
+	// memory to line it up with that tail.
+	overlapKeep = 256 * 1024
+	// anchorSize is how much of a truncated portion is used to find where it
+	// starts in the complete file.
+	anchorSize = 4096
+)
+
+// TruncatedError reports that RDS cut a log file portion short, losing the
+// data in between.  Data holds what was read, up to the truncation.
+type TruncatedError struct {
+	DB      string
+	LogFile string
+	Data    string
+}
+
+func (e *TruncatedError) Error() string {
+	return fmt.Sprintf("%s: %s: log file portion was truncated by rds", e.DB, e.LogFile)
+}
+
+// CompleteLogSource is implemented by log sources that can fetch a whole log
+// file at once, without the truncation DownloadDBLogFilePortion suffers from.
+// *rds.RDS gets this through a signed downloadCompleteLogFile request.
+type CompleteLogSource interface {
+	DownloadCompleteLogFile(db, name string) (io.ReadCloser, error)
+}
+
+func openCompleteLogFile(r LogSource, db, name string) (io.ReadCloser, error) {
+	switch s := r.(type) {
+	case CompleteLogSource:
+		return s.DownloadCompleteLogFile(db, name)
+	case *rds.RDS:
+		return downloadCompleteLogFile(s, db, name)
+	}
+	return nil, errors.New("log source can't download complete log files")
+}
+
+// downloadCompleteLogFile fetches a whole log file through the REST endpoint
+// RDS offers alongside its API, signing the request with the client's
+// credentials.
+func downloadCompleteLogFile(r *rds.RDS, db, name string) (io.ReadCloser, error) {
+	u := fmt.Sprintf("%s/v13/downloadCompleteLogFile/%s/%s", strings.TrimSuffix(r.Endpoint, "/"), url.PathEscape(db), name)
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	signer := v4.NewSigner(r.Config.Credentials)
+	if _, err := signer.Sign(req, nil, r.SigningName, r.SigningRegion, time.Now()); err != nil {
+		return nil, err
+	}
+
+	client := r.Config.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		return nil, fmt.Errorf("downloading complete log file %s: %s: %s", name, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return resp.Body, nil
+}
+
+// tailWriter keeps the last n bytes written to it.
+type tailWriter struct {
+	n   int
+	buf []byte
+}
+
+func (w *tailWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	if len(w.buf) > w.n {
+		w.buf = append(w.buf[:0], w.buf[len(w.buf)-w.n:]...)
+	}
+	return len(p), nil
+}
+
+// copyCompleteLogFile writes the whole of a log file to w and returns a
+// marker for its end.  The marker comes from tailing the file afterwards, and
+// whatever that tail shows was written after the complete download is
+// written to w as well, so nothing falls between the two.
+func copyCompleteLogFile(r LogSource, db, name string, w io.Writer) (string, error) {
+	body, err := openCompleteLogFile(r, db, name)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	end := &tailWriter{n: overlapKeep}
+	if _, err := io.Copy(io.MultiWriter(w, end), body); err != nil {
+		return "", err
+	}
+
+	tail, marker, err := tailLogFile(r, db, name, overlapLines, "")
+	if _, truncated := err.(*TruncatedError); truncated {
+		// Long lines, make do with just the last one
+		tail, marker, err = tailLogFile(r, db, name, 1, "")
+		if _, truncated := err.(*TruncatedError); truncated {
+			return marker, nil
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	if extra := unseen(string(end.buf), tail); extra != "" {
+		if _, err := io.WriteString(w, extra); err != nil {
+			return "", err
+		}
+	}
+	return marker, nil
+}
+
+// unseen returns the part of tail that doesn't overlap with the end of data.
+func unseen(data, tail string) string {
+	if strings.HasSuffix(data, tail) {
+		return ""
+	}
+	// Try the longest run of whole lines at the start of tail that data ends with
+	for i := len(tail) - 1; i >= 0; i-- {
+		if tail[i] == '\n' && strings.HasSuffix(data, tail[:i+1]) {
+			return tail[i+1:]
+		}
+	}
+	if data != "" {
+		log.Printf("complete log file download and tail don't line up, some lines may be missing or repeated")
+	}
+	return tail
+}
+
+// recoverTruncated replaces a truncated read of a log file with the same
+// stretch of the file taken from a complete download, running to its end.
+// The read started offset bytes or more into the file.
+func recoverTruncated(r LogSource, db, name, truncated string, offset int64) (string, string, error) {
+	anchor := strings.TrimRight(truncated, "\r\n")
+	if i := strings.LastIndex(anchor, TruncationMarker); i >= 0 {
+		anchor = anchor[:i]
+	}
+	if len(anchor) > anchorSize {
+		anchor = anchor[:anchorSize]
+	}
+	if anchor == "" {
+		return "", "", errors.New("nothing left of the truncated portion to find it by")
+	}
+
+	var buf bytes.Buffer
+	marker, err := copyCompleteLogFile(r, db, name, &buf)
+	if err != nil {
+		return "", "", err
+	}
+	full := buf.String()
+	// Identical lines may come before the portion, in what was read already,
+	// or after it, so the first match past what was read is the one
+	if offset > int64(len(full)) {
+		offset = int64(len(full))
+	}
+	start := strings.Index(full[offset:], anchor)
+	if start < 0 {
+		return "", "", errors.New("truncated portion not found in complete log file")
+	}
+	return full[offset+int64(start):], marker, nil
+}
+
+// readLogFile reads name from pos on for Watch.  If RDS truncates the read,
+// the truncation is logged and the lines are taken from a complete download
+// of the file instead.  Should that fail too, the truncated lines are used as
+// they are.
+func readLogFile(r LogSource, db, name string, pos position) (string, position, error) {
+	lines, newMarker, err := tailLogFile(r, db, name, 0, pos.marker)
+	terr, ok := err.(*TruncatedError)
+	if !ok {
+		return lines, position{newMarker, pos.offset + int64(len(lines))}, err
+	}
+
+	log.Printf("%s, falling back to a complete download", terr)
+	full, fullMarker, err := recoverTruncated(r, db, name, terr.Data, pos.offset)
+	if err != nil {
+		// How much the truncation left out is anyone's guess
+		log.Printf("%s: %s: complete download failed, lines are missing: %s", db, name, err)
+		return lines, position{newMarker, pos.offset}, nil
+	}
+	return full, position{fullMarker, pos.offset + int64(len(full))}, nil
+}
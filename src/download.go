@@ -0,0 +1,201 @@
+package rdstail
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// markerSuffix names the file holding the marker of a download, kept next to
+// the downloaded file.
+const markerSuffix = ".marker"
+
+// Download saves the log files of db matching pattern that ListLogFiles
+// finds between since and until into dir, each under its RDS name, e.g.
+// dir/error/postgresql.log.2015-03-13-05.  With compress every file is
+// gzipped and gets a .gz suffix.
+//
+// The marker of each file is kept alongside it, so an interrupted download
+// resumes where it stopped, and downloading a file again only fetches what
+// was written since.
+func Download(r LogSource, db, pattern string, since, until time.Time, dir string, compress bool) error {
+	files, err := ListLogFiles(r, db, pattern, since, until)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		path := filepath.Join(dir, filepath.FromSlash(f.Name))
+		if compress {
+			path += ".gz"
+		}
+		n, err := downloadLogFile(r, db, f.Name, path, compress)
+		if err != nil {
+			return err
+		}
+		log.Printf("%s: %s, %d bytes", db, path, n)
+	}
+	return nil
+}
+
+func downloadLogFile(r LogSource, db, name, path string, compress bool) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return 0, err
+	}
+
+	marker, err := ioutil.ReadFile(path + markerSuffix)
+	if os.IsNotExist(err) {
+		marker = nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	if len(marker) == 0 {
+		// Without a marker there's nothing to resume, start over
+		flags |= os.O_TRUNC
+	}
+	out, err := os.OpenFile(path, flags, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer out.Close()
+
+	req := &rds.DownloadDBLogFilePortionInput{
+		DBInstanceIdentifier: aws.String(db),
+		LogFileName:          aws.String(name),
+	}
+	req.Marker = aws.String(startMarker)
+	if m := strings.TrimSpace(string(marker)); m != "" {
+		req.Marker = aws.String(m)
+	}
+
+	var n int64
+	var writeErr error
+	truncated := false
+	err = r.DownloadDBLogFilePortionPages(req, func(p *rds.DownloadDBLogFilePortionOutput, lastPage bool) bool {
+		data := aws.StringValue(p.LogFileData)
+		if endsTruncated(data) {
+			truncated = true
+			return false
+		}
+		if data != "" {
+			if writeErr = writePortion(out, data, compress); writeErr != nil {
+				return false
+			}
+			n += int64(len(data))
+		}
+		// Only move the marker on once the data it covers is on disk
+		if p.Marker != nil {
+			if writeErr = writeFileAtomic(path+markerSuffix, []byte(*p.Marker)); writeErr != nil {
+				return false
+			}
+		}
+		return true
+	})
+	if writeErr != nil {
+		return n, writeErr
+	}
+	if err != nil || !truncated {
+		return n, err
+	}
+
+	log.Printf("%s, falling back to a complete download", &TruncatedError{DB: db, LogFile: name})
+	return downloadCompleteLogFileTo(r, db, name, path, compress)
+}
+
+// downloadCompleteLogFileTo replaces the file at path with a complete download
+// of the log file, and records the marker for its end.
+func downloadCompleteLogFileTo(r LogSource, db, name, path string, compress bool) (int64, error) {
+	// Without the old marker an interrupted download starts over next time
+	if err := os.Remove(path + markerSuffix); err != nil && !os.IsNotExist(err) {
+		return 0, err
+	}
+	out, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer out.Close()
+
+	counter := &countingWriter{w: out}
+	var w io.Writer = counter
+	var gz *gzip.Writer
+	if compress {
+		gz = gzip.NewWriter(out)
+		counter.w = gz
+	}
+	marker, err := copyCompleteLogFile(r, db, name, w)
+	if err != nil {
+		return counter.n, err
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return counter.n, err
+		}
+	}
+	if err := out.Sync(); err != nil {
+		return counter.n, err
+	}
+	return counter.n, writeFileAtomic(path+markerSuffix, []byte(marker))
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// writePortion appends data to out.  Compressed portions are written as
+// gzip members of their own, which concatenate into a valid gzip file, so
+// every portion on disk is complete even if a later one is cut short.
+func writePortion(out *os.File, data string, compress bool) error {
+	var w io.Writer = out
+	var gz *gzip.Writer
+	if compress {
+		gz = gzip.NewWriter(out)
+		w = gz
+	}
+	if _, err := io.WriteString(w, data); err != nil {
+		return err
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return err
+		}
+	}
+	return out.Sync()
+}
+
+// writeFileAtomic replaces the file at path with data through a rename, so
+// it is never left half written.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,59 @@
+package rdstail_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/rdstailtest"
+)
+
+// manyLines returns more lines than RDS hands out without a marker.
+func manyLines(prefix string) string {
+	var lines []byte
+	for i := 0; i < rdstailtest.TailLines+500; i++ {
+		lines = append(lines, prefix+" "+strconv.Itoa(i)+"\n"...)
+	}
+	return string(lines)
+}
+
+func TestDownloadWholeFile(t *testing.T) {
+	src := rdstailtest.NewLogSource()
+	src.PageSize = 64 * 1024
+	data := manyLines("line")
+	src.Append(testDB, "error/postgresql.log.00", data)
+
+	dir, err := ioutil.TempDir("", "rdstail")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := rdstail.Download(src, testDB, "", time.Time{}, time.Time{}, dir, false); err != nil {
+		t.Fatalf("Download: %s", err)
+	}
+	got, err := ioutil.ReadFile(filepath.Join(dir, "error", "postgresql.log.00"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != data {
+		t.Errorf("downloaded %d bytes, want all %d of the file", len(got), len(data))
+	}
+
+	// Downloading again only fetches what was written since
+	src.Append(testDB, "error/postgresql.log.00", "more\n")
+	if err := rdstail.Download(src, testDB, "", time.Time{}, time.Time{}, dir, false); err != nil {
+		t.Fatalf("Download: %s", err)
+	}
+	got, err = ioutil.ReadFile(filepath.Join(dir, "error", "postgresql.log.00"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != data+"more\n" {
+		t.Errorf("downloaded %d bytes after resuming, want %d", len(got), len(data)+len("more\n"))
+	}
+}
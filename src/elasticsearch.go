@@ -0,0 +1,208 @@
+package rdstail
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const elasticsearchBatchSize = 500
+
+// ElasticsearchConfig describes an Elasticsearch or OpenSearch cluster and
+// the indexes to put events in.
+type ElasticsearchConfig struct {
+	URL string
+	// Index is the name of the index for each event.  Besides the
+	// placeholders of ExpandTemplate, it can hold dates in the Joda style
+	// Elasticsearch uses, like {yyyy.MM.dd}, filled in with the event's time.
+	Index    string
+	Headers  http.Header
+	Username string
+	Password string
+	Gzip     bool
+	// BatchSize is the most events sent in one _bulk request
+	BatchSize int
+	Client    *http.Client
+}
+
+// ElasticsearchSink indexes events through the _bulk API.  Documents the
+// cluster turns away for lack of capacity are sent again, on their own, and
+// those it rejects outright are logged and dropped.
+type ElasticsearchSink struct {
+	cfg ElasticsearchConfig
+}
+
+func NewElasticsearchSink(cfg ElasticsearchConfig) *ElasticsearchSink {
+	cfg.URL = strings.TrimSuffix(cfg.URL, "/")
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = elasticsearchBatchSize
+	}
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+	return &ElasticsearchSink{cfg: cfg}
+}
+
+// bulkItem is a document along with the index it goes to.
+type bulkItem struct {
+	index string
+	doc   []byte
+}
+
+func (s *ElasticsearchSink) Send(events []Event) error {
+	now := time.Now()
+	items := make([]bulkItem, 0, len(events))
+	for i := range events {
+		d := NewDocument(&events[i], now)
+		doc, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		items = append(items, bulkItem{index: ExpandIndex(s.cfg.Index, &events[i], d.Timestamp), doc: doc})
+	}
+
+	for len(items) > 0 {
+		n := len(items)
+		if n > s.cfg.BatchSize {
+			n = s.cfg.BatchSize
+		}
+		if err := s.bulkWithRetry(items[:n]); err != nil {
+			return err
+		}
+		items = items[n:]
+	}
+	return nil
+}
+
+// bulkWithRetry indexes items, sending those rejected with a 429 or 5xx
+// again until they all made it or httpRetryDeadline passed.
+func (s *ElasticsearchSink) bulkWithRetry(items []bulkItem) error {
+	deadline := time.Now().Add(httpRetryDeadline)
+	for failures := 0; ; failures++ {
+		retry, err := s.bulk(items)
+		if err != nil {
+			return err
+		}
+		if len(retry) == 0 {
+			return nil
+		}
+		wait := retryDelay(failures, httpRetryMin, httpRetryMax)
+		if time.Now().Add(wait).After(deadline) {
+			return fmt.Errorf("elasticsearch: %d documents still rejected after retrying", len(retry))
+		}
+		log.Printf("elasticsearch: %d of %d documents rejected, retrying them in %s", len(retry), len(items), wait)
+		time.Sleep(wait)
+		items = retry
+	}
+}
+
+type bulkResponse struct {
+	Errors bool                                `json:"errors"`
+	Items  []map[string]bulkResponseItemResult `json:"items"`
+}
+
+type bulkResponseItemResult struct {
+	Status int             `json:"status"`
+	Error  json.RawMessage `json:"error"`
+}
+
+// bulk sends items in one _bulk request and returns those that should be
+// tried again.
+func (s *ElasticsearchSink) bulk(items []bulkItem) ([]bulkItem, error) {
+	var buf bytes.Buffer
+	for _, item := range items {
+		action, err := json.Marshal(map[string]map[string]string{"index": {"_index": item.index}})
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(action)
+		buf.WriteByte('\n')
+		buf.Write(item.doc)
+		buf.WriteByte('\n')
+	}
+	body := buf.Bytes()
+	if s.cfg.Gzip {
+		var gzBuf bytes.Buffer
+		gz := gzip.NewWriter(&gzBuf)
+		gz.Write(body)
+		if err := gz.Close(); err != nil {
+			return nil, err
+		}
+		body = gzBuf.Bytes()
+	}
+
+	resp, err := doWithRetry(s.cfg.Client, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", s.cfg.URL+"/_bulk", bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range s.cfg.Headers {
+			req.Header[k] = v
+		}
+		req.Header.Set("Content-Type", "application/x-ndjson")
+		if s.cfg.Gzip {
+			req.Header.Set("Content-Encoding", "gzip")
+		}
+		if s.cfg.Username != "" {
+			req.SetBasicAuth(s.cfg.Username, s.cfg.Password)
+		}
+		return req, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result bulkResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("elasticsearch: reading _bulk response: %s", err)
+	}
+	if !result.Errors {
+		return nil, nil
+	}
+	if len(result.Items) != len(items) {
+		return nil, fmt.Errorf("elasticsearch: _bulk response has %d items for %d documents", len(result.Items), len(items))
+	}
+
+	var retry []bulkItem
+	for i, r := range result.Items {
+		for _, res := range r {
+			switch {
+			case res.Status < 300:
+			case res.Status == http.StatusTooManyRequests || res.Status >= 500:
+				retry = append(retry, items[i])
+			default:
+				log.Printf("elasticsearch: %s: dropping document: %d %s", items[i].index, res.Status, res.Error)
+			}
+		}
+	}
+	return retry, nil
+}
+
+func (s *ElasticsearchSink) Close() error {
+	return nil
+}
+
+var (
+	datePlaceholder = regexp.MustCompile(`\{[^{}]*\}`)
+	jodaDateParts   = strings.NewReplacer(
+		"yyyy", "2006", "yy", "06", "MM", "01", "dd", "02", "HH", "15", "mm", "04", "ss", "05",
+	)
+)
+
+// ExpandIndex fills in the placeholders of an index name, as ExpandTemplate
+// does, and the Joda style dates like {yyyy.MM.dd} with the UTC date of t.
+// Index names have to be lowercase, so the name is lowercased.
+func ExpandIndex(pattern string, e *Event, t time.Time) string {
+	name := ExpandTemplate(pattern, e)
+	name = datePlaceholder.ReplaceAllStringFunc(name, func(p string) string {
+		return t.UTC().Format(jodaDateParts.Replace(p[1 : len(p)-1]))
+	})
+	return strings.ToLower(name)
+}
@@ -0,0 +1,99 @@
+package rdstail_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/parser"
+)
+
+func TestElasticsearchRetriesOnlyTooManyRequests(t *testing.T) {
+	var mu sync.Mutex
+	var requests [][]string // messages of each _bulk request
+	var indexes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/_bulk" {
+			http.NotFound(w, r)
+			return
+		}
+		var messages []string
+		sc := bufio.NewScanner(r.Body)
+		for sc.Scan() {
+			var action struct {
+				Index struct {
+					Index string `json:"_index"`
+				} `json:"index"`
+			}
+			if err := json.Unmarshal(sc.Bytes(), &action); err != nil || !sc.Scan() {
+				http.Error(w, "bad action line", http.StatusBadRequest)
+				return
+			}
+			var doc rdstail.Document
+			if err := json.Unmarshal(sc.Bytes(), &doc); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			messages = append(messages, doc.Message)
+			mu.Lock()
+			indexes = append(indexes, action.Index.Index)
+			mu.Unlock()
+		}
+
+		mu.Lock()
+		requests = append(requests, messages)
+		first := len(requests) == 1
+		mu.Unlock()
+
+		// The first time around some documents are turned away, for lack of
+		// capacity or for good
+		var items []string
+		for _, msg := range messages {
+			status, errs := 201, ""
+			if first {
+				switch msg {
+				case "busy 1", "busy 2":
+					status, errs = 429, `,"error":{"type":"es_rejected_execution_exception","reason":"rejected execution"}`
+				case "bad":
+					status, errs = 400, `,"error":{"type":"mapper_parsing_exception","reason":"failed to parse"}`
+				}
+			}
+			items = append(items, fmt.Sprintf(`{"index":{"_index":"x","status":%d%s}}`, status, errs))
+		}
+		fmt.Fprintf(w, `{"took":3,"errors":%t,"items":[%s]}`, first, strings.Join(items, ","))
+	}))
+	defer srv.Close()
+
+	sink := rdstail.NewElasticsearchSink(rdstail.ElasticsearchConfig{URL: srv.URL + "/", Index: "rds-{instance}-{yyyy.MM.dd}"})
+	when := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	var events []rdstail.Event
+	for _, msg := range []string{"ok 1", "busy 1", "bad", "ok 2", "busy 2"} {
+		events = append(events, rdstail.Event{
+			Instance: rdstail.Instance{ID: "Prod-DB"},
+			LogFile:  "error/postgresql.log.00",
+			Event:    parser.Event{Time: when, Message: msg},
+		})
+	}
+	if err := sink.Send(events); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := "[[ok 1 busy 1 bad ok 2 busy 2] [busy 1 busy 2]]"
+	if got := fmt.Sprint(requests); got != want {
+		t.Errorf("got requests %s, want %s", got, want)
+	}
+	for _, index := range indexes {
+		if index != "rds-prod-db-2020.01.01" {
+			t.Errorf("document indexed in %q, want rds-prod-db-2020.01.01", index)
+		}
+	}
+}
@@ -0,0 +1,364 @@
+package rdstail
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net"
+	"time"
+)
+
+const fluentTimeout = 30 * time.Second
+
+// FluentConfig describes a Fluentd or Fluent Bit forward input.
+type FluentConfig struct {
+	// Network is tcp or unix
+	Network string
+	Addr    string
+	// Mode is forward for an array of entries per message, or packed for
+	// PackedForward's entries encoded back to back
+	Mode string
+	// Tag of each event, filled in as ExpandTemplate does, e.g.
+	// rds.{instance}.{logtype}
+	Tag string
+	// Ack waits for the server to acknowledge every message before moving on
+	Ack bool
+}
+
+// FluentSink sends events to Fluentd or Fluent Bit over the forward
+// protocol, one message per tag for each call to Send.
+type FluentSink struct {
+	cfg  FluentConfig
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func NewFluentSink(cfg FluentConfig) (*FluentSink, error) {
+	switch cfg.Network {
+	case "tcp", "unix":
+	default:
+		return nil, fmt.Errorf("unknown fluent network %q, expected tcp or unix", cfg.Network)
+	}
+	switch cfg.Mode {
+	case "":
+		cfg.Mode = "forward"
+	case "forward", "packed":
+	default:
+		return nil, fmt.Errorf("unknown fluent mode %q, expected forward or packed", cfg.Mode)
+	}
+	s := &FluentSink{cfg: cfg}
+	if err := s.dial(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FluentSink) dial() error {
+	conn, err := net.DialTimeout(s.cfg.Network, s.cfg.Addr, fluentTimeout)
+	if err != nil {
+		return err
+	}
+	s.conn = conn
+	s.r = bufio.NewReader(conn)
+	return nil
+}
+
+func (s *FluentSink) Send(events []Event) error {
+	now := time.Now()
+	var tags []string
+	entries := make(map[string][]byte)
+	counts := make(map[string]int)
+	for i := range events {
+		e := &events[i]
+		if e.Raw == "" && e.Message == "" {
+			continue
+		}
+		tag := ExpandTemplate(s.cfg.Tag, e)
+		if _, ok := entries[tag]; !ok {
+			tags = append(tags, tag)
+		}
+		entries[tag] = appendFluentEntry(entries[tag], e, now)
+		counts[tag]++
+	}
+
+	for _, tag := range tags {
+		var chunk string
+		if s.cfg.Ack {
+			id := make([]byte, 16)
+			if _, err := rand.Read(id); err != nil {
+				return err
+			}
+			chunk = base64.StdEncoding.EncodeToString(id)
+		}
+		if err := s.write(fluentMessage(tag, entries[tag], counts[tag], s.cfg.Mode == "packed", chunk), chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write sends one message, redialing first if the last one failed, and
+// waits for its ack if chunk is set.
+func (s *FluentSink) write(msg []byte, chunk string) error {
+	if s.conn == nil {
+		if err := s.dial(); err != nil {
+			return err
+		}
+		log.Printf("fluent: reconnected to %s", s.cfg.Addr)
+	}
+
+	s.conn.SetDeadline(time.Now().Add(fluentTimeout))
+	_, err := s.conn.Write(msg)
+	if err == nil && chunk != "" {
+		err = s.readAck(chunk)
+	}
+	if err != nil {
+		s.conn.Close()
+		s.conn = nil
+	}
+	return err
+}
+
+// readAck reads the server's response to a message sent with chunk, a map
+// like {"ack": chunk}.
+func (s *FluentSink) readAck(chunk string) error {
+	n, err := readMsgpackMapHeader(s.r)
+	if err != nil {
+		return fmt.Errorf("fluent: reading ack: %s", err)
+	}
+	resp := make(map[string]string, n)
+	for i := 0; i < n; i++ {
+		k, err := readMsgpackString(s.r)
+		if err != nil {
+			return fmt.Errorf("fluent: reading ack: %s", err)
+		}
+		v, err := readMsgpackString(s.r)
+		if err != nil {
+			return fmt.Errorf("fluent: reading ack: %s", err)
+		}
+		resp[k] = v
+	}
+	if resp["ack"] != chunk {
+		return fmt.Errorf("fluent: got ack %q for chunk %q", resp["ack"], chunk)
+	}
+	return nil
+}
+
+func (s *FluentSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
+
+// fluentMessage encodes a Forward or PackedForward mode message of count
+// entries:
+//
+//	[tag, [[time, record], ...], option]
+//	[tag, bin(entries), option]
+//
+// asking for an ack if chunk is set.
+func fluentMessage(tag string, entries []byte, count int, packed bool, chunk string) []byte {
+	var b []byte
+	b = appendMsgpackArrayHeader(b, 3)
+	b = appendMsgpackString(b, tag)
+	if packed {
+		b = appendMsgpackBin(b, entries)
+	} else {
+		b = appendMsgpackArrayHeader(b, count)
+		b = append(b, entries...)
+	}
+	if chunk != "" {
+		b = appendMsgpackMapHeader(b, 2)
+		b = appendMsgpackString(b, "chunk")
+		b = appendMsgpackString(b, chunk)
+	} else {
+		b = appendMsgpackMapHeader(b, 1)
+	}
+	b = appendMsgpackString(b, "size")
+	return appendMsgpackInt(b, int64(count))
+}
+
+// appendFluentEntry appends the [time, record] entry for e, its record
+// holding the fields of its Document.
+func appendFluentEntry(b []byte, e *Event, fallback time.Time) []byte {
+	d := NewDocument(e, fallback)
+	record := [][2]string{
+		{"message", d.Message},
+		{"instance", d.Instance},
+		{"log_file", d.LogFile},
+		{"engine", d.Engine},
+		{"cluster", d.Cluster},
+		{"role", d.Role},
+		{"severity", d.Severity},
+		{"user", d.User},
+		{"database", d.Database},
+		{"client_host", d.ClientHost},
+	}
+	n := 0
+	for _, kv := range record {
+		if kv[1] != "" {
+			n++
+		}
+	}
+	if d.PID != 0 {
+		n++
+	}
+	if len(d.Fields) > 0 {
+		n++
+	}
+
+	b = appendMsgpackArrayHeader(b, 2)
+	b = appendMsgpackEventTime(b, d.Timestamp)
+	b = appendMsgpackMapHeader(b, n)
+	for _, kv := range record {
+		if kv[1] != "" {
+			b = appendMsgpackString(b, kv[0])
+			b = appendMsgpackString(b, kv[1])
+		}
+	}
+	if d.PID != 0 {
+		b = appendMsgpackString(b, "pid")
+		b = appendMsgpackInt(b, int64(d.PID))
+	}
+	if len(d.Fields) > 0 {
+		b = appendMsgpackString(b, "fields")
+		b = appendMsgpackMapHeader(b, len(d.Fields))
+		for k, v := range d.Fields {
+			b = appendMsgpackString(b, k)
+			b = appendMsgpackString(b, v)
+		}
+	}
+	return b
+}
+
+// appendMsgpackEventTime appends t as the forward protocol's EventTime, a
+// fixext 8 of extension type 0 holding seconds and nanoseconds.
+func appendMsgpackEventTime(b []byte, t time.Time) []byte {
+	b = append(b, 0xd7, 0x00)
+	b = appendUint32(b, uint32(t.Unix()))
+	return appendUint32(b, uint32(t.Nanosecond()))
+}
+
+func appendMsgpackArrayHeader(b []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(b, 0x90|byte(n))
+	case n <= math.MaxUint16:
+		return appendUint16(append(b, 0xdc), uint16(n))
+	}
+	return appendUint32(append(b, 0xdd), uint32(n))
+}
+
+func appendMsgpackMapHeader(b []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(b, 0x80|byte(n))
+	case n <= math.MaxUint16:
+		return appendUint16(append(b, 0xde), uint16(n))
+	}
+	return appendUint32(append(b, 0xdf), uint32(n))
+}
+
+func appendMsgpackString(b []byte, s string) []byte {
+	switch n := len(s); {
+	case n < 32:
+		b = append(b, 0xa0|byte(n))
+	case n <= math.MaxUint8:
+		b = append(b, 0xd9, byte(n))
+	case n <= math.MaxUint16:
+		b = appendUint16(append(b, 0xda), uint16(n))
+	default:
+		b = appendUint32(append(b, 0xdb), uint32(n))
+	}
+	return append(b, s...)
+}
+
+func appendMsgpackBin(b []byte, data []byte) []byte {
+	switch n := len(data); {
+	case n <= math.MaxUint8:
+		b = append(b, 0xc4, byte(n))
+	case n <= math.MaxUint16:
+		b = appendUint16(append(b, 0xc5), uint16(n))
+	default:
+		b = appendUint32(append(b, 0xc6), uint32(n))
+	}
+	return append(b, data...)
+}
+
+func appendMsgpackInt(b []byte, v int64) []byte {
+	switch {
+	case v >= 0 && v < 128:
+		return append(b, byte(v))
+	case v >= math.MinInt32 && v <= math.MaxInt32:
+		return appendUint32(append(b, 0xd2), uint32(v))
+	}
+	b = append(b, 0xd3)
+	return appendUint32(appendUint32(b, uint32(uint64(v)>>32)), uint32(v))
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func readMsgpackMapHeader(r *bufio.Reader) (int, error) {
+	c, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case c&0xf0 == 0x80:
+		return int(c & 0x0f), nil
+	case c == 0xde:
+		n, err := readMsgpackLength(r, 2)
+		return int(n), err
+	case c == 0xdf:
+		n, err := readMsgpackLength(r, 4)
+		return int(n), err
+	}
+	return 0, fmt.Errorf("expected a msgpack map, got type 0x%02x", c)
+}
+
+// readMsgpackString reads a msgpack str, or bin as some servers send.
+func readMsgpackString(r *bufio.Reader) (string, error) {
+	c, err := r.ReadByte()
+	if err != nil {
+		return "", err
+	}
+	var n uint32
+	switch {
+	case c&0xe0 == 0xa0:
+		n = uint32(c & 0x1f)
+	case c == 0xd9 || c == 0xc4:
+		n, err = readMsgpackLength(r, 1)
+	case c == 0xda || c == 0xc5:
+		n, err = readMsgpackLength(r, 2)
+	case c == 0xdb || c == 0xc6:
+		n, err = readMsgpackLength(r, 4)
+	default:
+		return "", fmt.Errorf("expected a msgpack string, got type 0x%02x", c)
+	}
+	if err != nil {
+		return "", err
+	}
+	buf := make([]byte, n)
+	_, err = io.ReadFull(r, buf)
+	return string(buf), err
+}
+
+func readMsgpackLength(r *bufio.Reader, size int) (uint32, error) {
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(r, buf[4-size:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(buf), nil
+}
@@ -0,0 +1,403 @@
+package rdstail_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/parser"
+)
+
+// msgpackExt is a msgpack extension value, such as an EventTime.
+type msgpackExt struct {
+	typ  int8
+	data []byte
+}
+
+// decodeMsgpack reads one msgpack value.  Maps come back as
+// map[string]interface{}, integers as int64 and bins as []byte.
+func decodeMsgpack(r *bufio.Reader) (interface{}, error) {
+	c, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	readN := func(size int) (uint64, error) {
+		buf := make([]byte, 8)
+		if _, err := io.ReadFull(r, buf[8-size:]); err != nil {
+			return 0, err
+		}
+		return binary.BigEndian.Uint64(buf), nil
+	}
+	readBytes := func(n uint64) ([]byte, error) {
+		buf := make([]byte, n)
+		_, err := io.ReadFull(r, buf)
+		return buf, err
+	}
+	collection := func(n uint64, isMap bool) (interface{}, error) {
+		if !isMap {
+			a := make([]interface{}, n)
+			for i := range a {
+				if a[i], err = decodeMsgpack(r); err != nil {
+					return nil, err
+				}
+			}
+			return a, nil
+		}
+		m := make(map[string]interface{}, n)
+		for i := uint64(0); i < n; i++ {
+			k, err := decodeMsgpack(r)
+			if err != nil {
+				return nil, err
+			}
+			key, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("map key %v isn't a string", k)
+			}
+			if m[key], err = decodeMsgpack(r); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	}
+
+	switch {
+	case c < 0x80:
+		return int64(c), nil
+	case c&0xf0 == 0x80:
+		return collection(uint64(c&0x0f), true)
+	case c&0xf0 == 0x90:
+		return collection(uint64(c&0x0f), false)
+	case c&0xe0 == 0xa0:
+		b, err := readBytes(uint64(c & 0x1f))
+		return string(b), err
+	case c >= 0xe0:
+		return int64(int8(c)), nil
+	}
+	switch c {
+	case 0xc0:
+		return nil, nil
+	case 0xc2, 0xc3:
+		return c == 0xc3, nil
+	case 0xc4, 0xc5, 0xc6, 0xd9, 0xda, 0xdb:
+		size := map[byte]int{0xc4: 1, 0xc5: 2, 0xc6: 4, 0xd9: 1, 0xda: 2, 0xdb: 4}[c]
+		n, err := readN(size)
+		if err != nil {
+			return nil, err
+		}
+		b, err := readBytes(n)
+		if c >= 0xd9 {
+			return string(b), err
+		}
+		return b, err
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		v, err := readN(map[byte]int{0xcc: 1, 0xcd: 2, 0xce: 4, 0xcf: 8}[c])
+		return int64(v), err
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := map[byte]int{0xd0: 1, 0xd1: 2, 0xd2: 4, 0xd3: 8}[c]
+		v, err := readN(size)
+		shift := uint(64 - 8*size)
+		return int64(v<<shift) >> shift, err
+	case 0xd4, 0xd5, 0xd6, 0xd7, 0xd8:
+		typ, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		data, err := readBytes(uint64(1) << (c - 0xd4))
+		return msgpackExt{int8(typ), data}, err
+	case 0xdc, 0xdd:
+		n, err := readN(map[byte]int{0xdc: 2, 0xdd: 4}[c])
+		if err != nil {
+			return nil, err
+		}
+		return collection(n, false)
+	case 0xde, 0xdf:
+		n, err := readN(map[byte]int{0xde: 2, 0xdf: 4}[c])
+		if err != nil {
+			return nil, err
+		}
+		return collection(n, true)
+	}
+	return nil, fmt.Errorf("unexpected msgpack type 0x%02x", c)
+}
+
+// fluentEntry is a decoded [time, record] entry.
+type fluentEntry struct {
+	time   time.Time
+	record map[string]interface{}
+}
+
+// fluentMessage is a decoded forward protocol message, its entries unpacked.
+type fluentMessage struct {
+	tag     string
+	packed  bool
+	entries []fluentEntry
+	option  map[string]interface{}
+}
+
+func decodeFluentEntry(v interface{}) (fluentEntry, error) {
+	a, ok := v.([]interface{})
+	if !ok || len(a) != 2 {
+		return fluentEntry{}, fmt.Errorf("entry %v isn't [time, record]", v)
+	}
+	ext, ok := a[0].(msgpackExt)
+	if !ok || ext.typ != 0 || len(ext.data) != 8 {
+		return fluentEntry{}, fmt.Errorf("entry time %v isn't an EventTime", a[0])
+	}
+	record, ok := a[1].(map[string]interface{})
+	if !ok {
+		return fluentEntry{}, fmt.Errorf("entry record %v isn't a map", a[1])
+	}
+	t := time.Unix(int64(binary.BigEndian.Uint32(ext.data)), int64(binary.BigEndian.Uint32(ext.data[4:])))
+	return fluentEntry{time: t, record: record}, nil
+}
+
+func decodeFluentMessage(v interface{}) (fluentMessage, error) {
+	a, ok := v.([]interface{})
+	if !ok || len(a) != 3 {
+		return fluentMessage{}, fmt.Errorf("message %v isn't [tag, entries, option]", v)
+	}
+	var msg fluentMessage
+	msg.tag, _ = a[0].(string)
+	msg.option, _ = a[2].(map[string]interface{})
+
+	var entries []interface{}
+	switch e := a[1].(type) {
+	case []interface{}:
+		entries = e
+	case []byte:
+		msg.packed = true
+		r := bufio.NewReader(bytes.NewReader(e))
+		for {
+			v, err := decodeMsgpack(r)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return msg, err
+			}
+			entries = append(entries, v)
+		}
+	default:
+		return msg, fmt.Errorf("entries %v are neither an array nor packed", a[1])
+	}
+	for _, v := range entries {
+		entry, err := decodeFluentEntry(v)
+		if err != nil {
+			return msg, err
+		}
+		msg.entries = append(msg.entries, entry)
+	}
+	return msg, nil
+}
+
+// fluentServer is a forward input, acking chunks with ack, which returns the
+// chunk by default.
+type fluentServer struct {
+	net.Listener
+
+	mu       sync.Mutex
+	ack      func(chunk string) string
+	messages []fluentMessage
+	errs     []error
+	conns    int
+}
+
+func newFluentServer(t *testing.T) *fluentServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fluentServer{Listener: l, ack: func(chunk string) string { return chunk }}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.conns++
+			s.mu.Unlock()
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fluentServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		v, err := decodeMsgpack(r)
+		if err == io.EOF {
+			return
+		}
+		var msg fluentMessage
+		if err == nil {
+			msg, err = decodeFluentMessage(v)
+		}
+		s.mu.Lock()
+		if err != nil {
+			s.errs = append(s.errs, err)
+			s.mu.Unlock()
+			return
+		}
+		s.messages = append(s.messages, msg)
+		ack := s.ack
+		s.mu.Unlock()
+
+		// {"ack": chunk}
+		if chunk, ok := msg.option["chunk"].(string); ok {
+			resp := ack(chunk)
+			conn.Write(append([]byte{0x81, 0xa3, 'a', 'c', 'k', 0xd9, byte(len(resp))}, resp...))
+		}
+	}
+}
+
+// received waits for n messages, failing on any the server couldn't decode.
+func (s *fluentServer) received(t *testing.T, n int) []fluentMessage {
+	t.Helper()
+	waitUntil(t, fmt.Sprintf("%d messages", n), func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.messages) >= n || len(s.errs) > 0
+	})
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.errs) > 0 {
+		t.Fatalf("server couldn't decode a message: %s", s.errs[0])
+	}
+	return append([]fluentMessage(nil), s.messages...)
+}
+
+func fluentEvents(when time.Time) []rdstail.Event {
+	return []rdstail.Event{
+		{
+			Instance: rdstail.Instance{ID: "prod-db", Engine: "postgres"},
+			LogFile:  "error/postgresql.log.00",
+			Event: parser.Event{Time: when, Severity: "ERROR", User: "alice", PID: 1234, Message: "oops",
+				Fields: map[string]string{"statement": "select 1"}},
+		},
+		{
+			Instance: rdstail.Instance{ID: "prod-db", Engine: "postgres"},
+			LogFile:  "error/postgresql.log.00",
+			Event:    parser.Event{Time: when.Add(time.Second), Severity: "LOG", Message: "checkpoint"},
+		},
+		{
+			Instance: rdstail.Instance{ID: "prod-mysql", Engine: "mysql"},
+			LogFile:  "slowquery/mysql-slowquery.log",
+			Event:    parser.Event{Time: when, Message: "slow"},
+		},
+	}
+}
+
+// checkFluentMessages checks events came through as a message per tag.
+func checkFluentMessages(t *testing.T, msgs []fluentMessage, packed bool, when time.Time) {
+	t.Helper()
+	if len(msgs) != 2 {
+		t.Fatalf("got %d messages, want one for each of 2 tags", len(msgs))
+	}
+	pg, my := msgs[0], msgs[1]
+	if pg.tag != "rds.prod-db.error" || my.tag != "rds.prod-mysql.slowquery" {
+		t.Errorf("got tags %q and %q, want rds.prod-db.error and rds.prod-mysql.slowquery", pg.tag, my.tag)
+	}
+	for _, msg := range msgs {
+		if msg.packed != packed {
+			t.Errorf("message %s packed is %t, want %t", msg.tag, msg.packed, packed)
+		}
+		if size, _ := msg.option["size"].(int64); size != int64(len(msg.entries)) {
+			t.Errorf("message %s has size option %v for %d entries", msg.tag, msg.option["size"], len(msg.entries))
+		}
+	}
+	if len(pg.entries) != 2 || len(my.entries) != 1 {
+		t.Fatalf("got %d and %d entries, want 2 and 1", len(pg.entries), len(my.entries))
+	}
+
+	oops := pg.entries[0]
+	if !oops.time.Equal(when) {
+		t.Errorf("entry time is %s, want %s", oops.time, when)
+	}
+	want := map[string]interface{}{
+		"message": "oops", "instance": "prod-db", "engine": "postgres", "log_file": "error/postgresql.log.00",
+		"severity": "ERROR", "user": "alice", "pid": int64(1234),
+		"fields": map[string]interface{}{"statement": "select 1"},
+	}
+	if fmt.Sprint(oops.record) != fmt.Sprint(want) {
+		t.Errorf("got record %v, want %v", oops.record, want)
+	}
+	if !pg.entries[1].time.Equal(when.Add(time.Second)) || pg.entries[1].record["message"] != "checkpoint" {
+		t.Errorf("got second entry %v, want the checkpoint a second later", pg.entries[1])
+	}
+	if my.entries[0].record["message"] != "slow" {
+		t.Errorf("got %v, want the slow query", my.entries[0])
+	}
+}
+
+func TestFluentForward(t *testing.T) {
+	when := time.Date(2020, 1, 2, 10, 4, 5, 123456789, time.UTC)
+	for _, mode := range []string{"forward", "packed"} {
+		srv := newFluentServer(t)
+		sink, err := rdstail.NewFluentSink(rdstail.FluentConfig{Network: "tcp", Addr: srv.Addr().String(), Mode: mode, Tag: "rds.{instance}.{logtype}"})
+		if err != nil {
+			t.Fatalf("NewFluentSink: %s", err)
+		}
+		if err := sink.Send(fluentEvents(when)); err != nil {
+			t.Fatalf("Send: %s", err)
+		}
+		msgs := srv.received(t, 2)
+		if _, ok := msgs[0].option["chunk"]; ok {
+			t.Errorf("%s message asks for an ack without -ack", mode)
+		}
+		checkFluentMessages(t, msgs, mode == "packed", when)
+		sink.Close()
+		srv.Close()
+	}
+}
+
+func TestFluentAck(t *testing.T) {
+	srv := newFluentServer(t)
+	defer srv.Close()
+	sink, err := rdstail.NewFluentSink(rdstail.FluentConfig{Network: "tcp", Addr: srv.Addr().String(), Tag: "rds.{instance}.{logtype}", Ack: true})
+	if err != nil {
+		t.Fatalf("NewFluentSink: %s", err)
+	}
+	defer sink.Close()
+
+	when := time.Date(2020, 1, 2, 10, 4, 5, 0, time.UTC)
+	if err := sink.Send(fluentEvents(when)); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+	msgs := srv.received(t, 2)
+	checkFluentMessages(t, msgs, false, when)
+	first, _ := msgs[0].option["chunk"].(string)
+	second, _ := msgs[1].option["chunk"].(string)
+	if first == "" || first == second {
+		t.Errorf("got chunks %q and %q, want a different one for each message", first, second)
+	}
+
+	// An ack for some other chunk doesn't count, and the connection is
+	// started over
+	srv.mu.Lock()
+	srv.ack = func(string) string { return "someone else's" }
+	srv.mu.Unlock()
+	if err := sink.Send(fluentEvents(when)[:1]); err == nil {
+		t.Fatalf("Send succeeded with the wrong ack")
+	}
+	srv.mu.Lock()
+	srv.ack = func(chunk string) string { return chunk }
+	srv.mu.Unlock()
+	if err := sink.Send(fluentEvents(when)[:1]); err != nil {
+		t.Fatalf("Send after the wrong ack: %s", err)
+	}
+	srv.received(t, 4)
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if srv.conns != 2 {
+		t.Errorf("got %d connections, want the sink to redial once", srv.conns)
+	}
+}
@@ -0,0 +1,297 @@
+package rdstail
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// gelfChunkSize is the biggest datagram sent unless GELFConfig.ChunkSize
+	// says otherwise, small enough to get through most networks unfragmented
+	gelfChunkSize    = 1420
+	gelfChunkHeader  = 12
+	gelfMaxChunks    = 128
+	gelfWriteTimeout = 30 * time.Second
+)
+
+var gelfChunkMagic = []byte{0x1e, 0x0f}
+
+// GELFConfig describes a Graylog GELF input.
+type GELFConfig struct {
+	// Network is udp or tcp
+	Network string
+	Addr    string
+	// Compression of udp messages: gzip, zlib or none.  Messages over tcp
+	// are never compressed.
+	Compression string
+	// ChunkSize is the biggest udp datagram to send, messages that don't fit
+	// are split into chunks
+	ChunkSize int
+}
+
+// GELFSink sends each event to Graylog as a GELF message, with the fields
+// parsed from the log line as additional fields.  Over tcp messages are
+// terminated by a null byte, over udp they are compressed and chunked.
+type GELFSink struct {
+	cfg  GELFConfig
+	conn net.Conn
+}
+
+func NewGELFSink(cfg GELFConfig) (*GELFSink, error) {
+	switch cfg.Network {
+	case "udp", "tcp":
+	default:
+		return nil, fmt.Errorf("unknown gelf network %q, expected udp or tcp", cfg.Network)
+	}
+	switch cfg.Compression {
+	case "":
+		cfg.Compression = "gzip"
+	case "gzip", "zlib", "none":
+	default:
+		return nil, fmt.Errorf("unknown gelf compression %q, expected gzip, zlib or none", cfg.Compression)
+	}
+	if cfg.ChunkSize <= gelfChunkHeader {
+		cfg.ChunkSize = gelfChunkSize
+	}
+	s := &GELFSink{cfg: cfg}
+	if err := s.dial(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *GELFSink) dial() error {
+	conn, err := net.DialTimeout(s.cfg.Network, s.cfg.Addr, gelfWriteTimeout)
+	if err != nil {
+		return err
+	}
+	s.conn = conn
+	return nil
+}
+
+var gelfFieldName = regexp.MustCompile(`[^\w.\-]`)
+
+// gelfMessage returns the GELF message for e.  The first line of the entry is
+// the short message, and an entry spanning several lines is kept whole as
+// the full message.
+func gelfMessage(e *Event, fallback time.Time) map[string]interface{} {
+	text := e.Message
+	if text == "" {
+		text = e.Raw
+	}
+	short := text
+	if i := strings.IndexByte(text, '\n'); i >= 0 {
+		short = text[:i]
+	}
+	if strings.TrimSpace(short) == "" {
+		short = "-"
+	}
+
+	t := e.Timestamp(fallback)
+	msg := map[string]interface{}{
+		"version":       "1.1",
+		"host":          e.Instance.ID,
+		"short_message": short,
+		"timestamp":     float64(t.UnixNano()/int64(time.Millisecond)) / 1000,
+		"level":         syslogLevel(e.Severity),
+		"_instance":     e.Instance.ID,
+		"_log_file":     e.LogFile,
+	}
+	if short != text {
+		msg["full_message"] = text
+	}
+	for k, v := range e.Fields {
+		k = "_" + gelfFieldName.ReplaceAllString(k, "_")
+		if k != "_id" {
+			msg[k] = v
+		}
+	}
+	for k, v := range map[string]string{
+		"_engine":      e.Instance.Engine,
+		"_cluster":     e.Instance.Cluster,
+		"_role":        e.Instance.Role,
+		"_severity":    e.Severity,
+		"_user":        e.User,
+		"_database":    e.Database,
+		"_client_host": e.ClientHost,
+	} {
+		if v != "" {
+			msg[k] = v
+		}
+	}
+	if e.PID != 0 {
+		msg["_pid"] = e.PID
+	}
+	return msg
+}
+
+func (s *GELFSink) Send(events []Event) error {
+	now := time.Now()
+	for i := range events {
+		if events[i].Raw == "" && events[i].Message == "" {
+			continue
+		}
+		msg := gelfMessage(&events[i], now)
+		var data []byte
+		var err error
+		if s.cfg.Network == "tcp" {
+			data, err = json.Marshal(msg)
+		} else {
+			data, err = s.encodeUDP(msg)
+		}
+		if err != nil {
+			return err
+		}
+		if err := s.write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeUDP marshals and compresses a message.  A message too big to send in
+// the chunks GELF allows has its text cut short until it fits, and is marked
+// _truncated.
+func (s *GELFSink) encodeUDP(msg map[string]interface{}) ([]byte, error) {
+	limit := gelfMaxChunks * (s.cfg.ChunkSize - gelfChunkHeader)
+	for {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		if data, err = s.compress(data); err != nil {
+			return nil, err
+		}
+		if len(data) <= limit {
+			return data, nil
+		}
+
+		// The full message is where a long statement ends up, failing that
+		// the short message is a single long line
+		key := "full_message"
+		text, ok := msg[key].(string)
+		if !ok {
+			key = "short_message"
+			text = msg[key].(string)
+			if len(text) <= 1 {
+				// Nothing left to cut, writeUDP will drop it
+				return data, nil
+			}
+		}
+		// Cut the bytes the message is over by, or its share of the text if
+		// that's more, as compressed it shrinks by less than was cut
+		keep := len(text) - (len(data) - limit)
+		if share := int(int64(len(text)) * int64(limit) / int64(len(data))); share < keep {
+			keep = share
+		}
+		if keep < 0 {
+			keep = 0
+		}
+		for keep > 0 && !utf8.RuneStart(text[keep]) {
+			keep--
+		}
+		switch {
+		case keep > 0:
+			msg[key] = text[:keep]
+		case key == "full_message":
+			delete(msg, key)
+		default:
+			msg[key] = "-"
+		}
+		msg["_truncated"] = true
+	}
+}
+
+func (s *GELFSink) compress(data []byte) ([]byte, error) {
+	if s.cfg.Compression == "none" {
+		return data, nil
+	}
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	if s.cfg.Compression == "zlib" {
+		w = zlib.NewWriter(&buf)
+	} else {
+		w = gzip.NewWriter(&buf)
+	}
+	w.Write(data)
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// write sends one message, redialing first if the last write failed.
+func (s *GELFSink) write(data []byte) error {
+	if s.conn == nil {
+		if err := s.dial(); err != nil {
+			return err
+		}
+		log.Printf("gelf: reconnected to %s", s.cfg.Addr)
+	}
+
+	var err error
+	if s.cfg.Network == "tcp" {
+		s.conn.SetWriteDeadline(time.Now().Add(gelfWriteTimeout))
+		_, err = s.conn.Write(append(data, 0))
+	} else {
+		err = s.writeUDP(data)
+	}
+	if err != nil {
+		s.conn.Close()
+		s.conn = nil
+	}
+	return err
+}
+
+// writeUDP sends an encoded message in as many datagrams as it takes.  A
+// message that still needs more chunks than GELF allows is dropped.
+func (s *GELFSink) writeUDP(data []byte) error {
+	if len(data) <= s.cfg.ChunkSize {
+		_, err := s.conn.Write(data)
+		return err
+	}
+
+	size := s.cfg.ChunkSize - gelfChunkHeader
+	count := (len(data) + size - 1) / size
+	if count > gelfMaxChunks {
+		log.Printf("gelf: dropping a message of %d bytes, it needs more than %d chunks", len(data), gelfMaxChunks)
+		return nil
+	}
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return err
+	}
+	chunk := make([]byte, 0, s.cfg.ChunkSize)
+	for seq := 0; seq < count; seq++ {
+		end := (seq + 1) * size
+		if end > len(data) {
+			end = len(data)
+		}
+		chunk = append(chunk[:0], gelfChunkMagic...)
+		chunk = append(chunk, id...)
+		chunk = append(chunk, byte(seq), byte(count))
+		chunk = append(chunk, data[seq*size:end]...)
+		if _, err := s.conn.Write(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *GELFSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
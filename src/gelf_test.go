@@ -0,0 +1,179 @@
+package rdstail_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/parser"
+)
+
+func newGELFInput(t *testing.T) net.PacketConn {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pc
+}
+
+// readGELF reassembles the next message sent to a udp input, checking no
+// datagram is bigger than chunkSize, and tells how many chunks it came in.
+func readGELF(t *testing.T, pc net.PacketConn, chunkSize int) (msg map[string]interface{}, chunks int) {
+	t.Helper()
+	pc.SetReadDeadline(time.Now().Add(2 * time.Second))
+	buf := make([]byte, 65536)
+	var data, id []byte
+	parts := make(map[byte][]byte)
+	for data == nil {
+		n, _, err := pc.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("reading gelf: %s", err)
+		}
+		if n > chunkSize {
+			t.Fatalf("got a datagram of %d bytes, want at most %d", n, chunkSize)
+		}
+		d := append([]byte(nil), buf[:n]...)
+		if !bytes.HasPrefix(d, []byte{0x1e, 0x0f}) {
+			data, chunks = d, 1
+			break
+		}
+		if id == nil {
+			id = d[2:10]
+		} else if !bytes.Equal(id, d[2:10]) {
+			t.Fatalf("got chunks of two messages")
+		}
+		seq, count := d[10], int(d[11])
+		parts[seq] = d[12:]
+		if len(parts) == count {
+			for i := 0; i < count; i++ {
+				data = append(data, parts[byte(i)]...)
+			}
+			chunks = count
+		}
+	}
+
+	switch {
+	case bytes.HasPrefix(data, []byte{0x1f, 0x8b}):
+		data = gunzipped(t, data)
+	case data[0] == 0x78:
+		zr, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data, err = ioutil.ReadAll(zr); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := json.Unmarshal(data, &msg); err != nil {
+		t.Fatalf("message isn't json: %s", err)
+	}
+	return msg, chunks
+}
+
+func gunzipped(t *testing.T, b []byte) []byte {
+	t.Helper()
+	b, err := gunzip(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// hexLines returns n bytes of lines that don't compress much.
+func hexLines(n int) string {
+	r := rand.New(rand.NewSource(1))
+	b := make([]byte, n/2)
+	r.Read(b)
+	s := hex.EncodeToString(b)
+	var lines []string
+	for len(s) > 64 {
+		lines = append(lines, s[:63])
+		s = s[64:]
+	}
+	return strings.Join(append(lines, s), "\n")
+}
+
+func gelfSend(t *testing.T, pc net.PacketConn, compression string, chunkSize int, text string) {
+	t.Helper()
+	sink, err := rdstail.NewGELFSink(rdstail.GELFConfig{
+		Network:     "udp",
+		Addr:        pc.LocalAddr().String(),
+		Compression: compression,
+		ChunkSize:   chunkSize,
+	})
+	if err != nil {
+		t.Fatalf("NewGELFSink: %s", err)
+	}
+	defer sink.Close()
+	err = sink.Send([]rdstail.Event{{
+		Instance: rdstail.Instance{ID: "prod-db", Engine: "postgres"},
+		LogFile:  "error/postgresql.log.00",
+		Event:    parser.Event{Severity: "ERROR", PID: 42, Message: text},
+	}})
+	if err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+}
+
+func TestGELFChunksUDP(t *testing.T) {
+	pc := newGELFInput(t)
+	defer pc.Close()
+
+	text := "statement:\n" + hexLines(6000)
+	for _, compression := range []string{"none", "gzip", "zlib"} {
+		gelfSend(t, pc, compression, 300, text)
+		msg, chunks := readGELF(t, pc, 300)
+		if chunks < 2 {
+			t.Errorf("%s: sent in %d chunks, want several", compression, chunks)
+		}
+		if msg["short_message"] != "statement:" || msg["full_message"] != text {
+			t.Errorf("%s: got short message %q and %d bytes of full message, want the %d byte entry",
+				compression, msg["short_message"], len(msg["full_message"].(string)), len(text))
+		}
+		if msg["host"] != "prod-db" || msg["_engine"] != "postgres" || msg["_pid"] != float64(42) || msg["level"] != float64(3) {
+			t.Errorf("%s: got message %v", compression, msg)
+		}
+		if _, ok := msg["_truncated"]; ok {
+			t.Errorf("%s: message that fits is marked truncated", compression)
+		}
+	}
+}
+
+func TestGELFTruncatesOversizedUDP(t *testing.T) {
+	pc := newGELFInput(t)
+	defer pc.Close()
+
+	// 128 chunks of 200 bytes hold 24064 bytes of message
+	long := hexLines(60000)
+	for _, compression := range []string{"none", "gzip"} {
+		for _, text := range []string{"statement:\n" + long, strings.Replace(long, "\n", " ", -1)} {
+			gelfSend(t, pc, compression, 200, text)
+			msg, chunks := readGELF(t, pc, 200)
+			if chunks > 128 {
+				t.Errorf("%s: sent in %d chunks", compression, chunks)
+			}
+			if msg["_truncated"] != true {
+				t.Errorf("%s: oversized message isn't marked truncated", compression)
+			}
+
+			key := "full_message"
+			if !strings.Contains(text, "\n") {
+				key = "short_message"
+			} else if msg["short_message"] != "statement:" {
+				t.Errorf("%s: got short message %q", compression, msg["short_message"])
+			}
+			cut, _ := msg[key].(string)
+			if len(cut) < len(text)/4 || len(cut) >= len(text) || !strings.HasPrefix(text, cut) {
+				t.Errorf("%s: %s is %d bytes, want the first part of the %d bytes", compression, key, len(cut), len(text))
+			}
+		}
+	}
+}
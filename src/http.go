@@ -0,0 +1,257 @@
+package rdstail
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	httpRetryMin      = time.Second
+	httpRetryMax      = time.Minute
+	httpRetryDeadline = time.Minute * 5
+
+	httpBatchSize = 500
+)
+
+// HTTPConfig describes where and how the HTTP sink posts events.
+type HTTPConfig struct {
+	URL string
+	// Format is json for a JSON array of events, or ndjson for one event per
+	// line
+	Format  string
+	Headers http.Header
+	Gzip    bool
+	// BatchSize is the most events sent in one request
+	BatchSize int
+	Client    *http.Client
+}
+
+// HTTPStatusError is an HTTP response that wasn't a success.
+type HTTPStatusError struct {
+	URL        string
+	Status     string
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", e.URL, e.Status, e.Body)
+}
+
+// retryable tells whether a request might get through if tried again later.
+func (e *HTTPStatusError) retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// rejected tells whether the server turned away the body itself, so sending
+// it again would only be turned away again.
+func (e *HTTPStatusError) rejected() bool {
+	switch e.StatusCode {
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge, http.StatusUnprocessableEntity:
+		return true
+	}
+	return false
+}
+
+// unauthorized tells whether the request was turned away for its credentials,
+// which no other request will get past either.
+func (e *HTTPStatusError) unauthorized() bool {
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusProxyAuthRequired:
+		return true
+	}
+	return false
+}
+
+// doWithRetry sends the request made by newRequest until it succeeds, retrying
+// network errors, 429s and 5xxs with exponential backoff, or as told by a
+// Retry-After header, for up to httpRetryDeadline.  A successful response is
+// returned for the caller to read and close.
+func doWithRetry(client *http.Client, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	deadline := time.Now().Add(httpRetryDeadline)
+	for failures := 0; ; failures++ {
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+
+		var wait time.Duration
+		if err == nil {
+			if resp.StatusCode/100 == 2 {
+				return resp, nil
+			}
+			body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+			resp.Body.Close()
+			serr := &HTTPStatusError{URL: req.URL.String(), Status: resp.Status, StatusCode: resp.StatusCode, Body: string(bytes.TrimSpace(body))}
+			if !serr.retryable() {
+				return nil, serr
+			}
+			err = serr
+			wait = retryAfter(resp.Header.Get("Retry-After"))
+		}
+		if wait <= 0 {
+			wait = retryDelay(failures, httpRetryMin, httpRetryMax)
+		}
+		if time.Now().Add(wait).After(deadline) {
+			return nil, err
+		}
+		log.Printf("http: %s, retrying in %s", err, wait)
+		time.Sleep(wait)
+	}
+}
+
+// retryAfter reads a Retry-After header, given in seconds or as a date.
+func retryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t.Sub(time.Now())
+	}
+	return 0
+}
+
+// retryDelay is how long to wait after failures failed tries in a row,
+// doubling from min up to max, with jitter.
+func retryDelay(failures int, min, max time.Duration) time.Duration {
+	wait := min << uint(failures)
+	if wait > max || wait <= 0 {
+		wait = max
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// HTTPSink posts events as JSON documents, in batches of at most BatchSize.
+type HTTPSink struct {
+	cfg HTTPConfig
+}
+
+func NewHTTPSink(cfg HTTPConfig) (*HTTPSink, error) {
+	switch cfg.Format {
+	case "":
+		cfg.Format = "json"
+	case "json", "ndjson":
+	default:
+		return nil, fmt.Errorf("unknown format %q, expected json or ndjson", cfg.Format)
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = httpBatchSize
+	}
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+	return &HTTPSink{cfg: cfg}, nil
+}
+
+// Send posts events, returning once every batch went out.
+func (s *HTTPSink) Send(events []Event) error {
+	docs := make([][]byte, 0, len(events))
+	now := time.Now()
+	for i := range events {
+		doc, err := json.Marshal(NewDocument(&events[i], now))
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+
+	for len(docs) > 0 {
+		n := len(docs)
+		if n > s.cfg.BatchSize {
+			n = s.cfg.BatchSize
+		}
+		if err := s.post(docs[:n]); err != nil {
+			return err
+		}
+		docs = docs[n:]
+	}
+	return nil
+}
+
+// post sends a batch of documents.  Batches the server turns down for good
+// are dropped.
+func (s *HTTPSink) post(docs [][]byte) error {
+	body, err := s.encode(docs)
+	if err != nil {
+		return err
+	}
+	resp, err := doWithRetry(s.cfg.Client, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", s.cfg.URL, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range s.cfg.Headers {
+			req.Header[k] = v
+		}
+		if s.cfg.Format == "ndjson" {
+			req.Header.Set("Content-Type", "application/x-ndjson")
+		} else {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if s.cfg.Gzip {
+			req.Header.Set("Content-Encoding", "gzip")
+		}
+		return req, nil
+	})
+	if serr, ok := err.(*HTTPStatusError); ok && serr.rejected() {
+		log.Printf("http: dropping %d events: %s", len(docs), err)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	return nil
+}
+
+func (s *HTTPSink) encode(docs [][]byte) ([]byte, error) {
+	var buf bytes.Buffer
+	var w io.Writer = &buf
+	var gz *gzip.Writer
+	if s.cfg.Gzip {
+		gz = gzip.NewWriter(&buf)
+		w = gz
+	}
+
+	sep := []byte(",")
+	if s.cfg.Format == "ndjson" {
+		sep = []byte("\n")
+	} else {
+		w.Write([]byte("["))
+	}
+	for i, doc := range docs {
+		if i > 0 {
+			w.Write(sep)
+		}
+		w.Write(doc)
+	}
+	if s.cfg.Format == "ndjson" {
+		w.Write(sep)
+	} else {
+		w.Write([]byte("]"))
+	}
+
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *HTTPSink) Close() error {
+	return nil
+}
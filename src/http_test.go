@@ -0,0 +1,90 @@
+package rdstail_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/parser"
+)
+
+func TestHTTPSinkPostsBeforeReturning(t *testing.T) {
+	var mu sync.Mutex
+	var batches [][]rdstail.Document
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var docs []rdstail.Document
+		if err := json.NewDecoder(r.Body).Decode(&docs); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		batches = append(batches, docs)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	sink, err := rdstail.NewHTTPSink(rdstail.HTTPConfig{URL: srv.URL, BatchSize: 2})
+	if err != nil {
+		t.Fatalf("NewHTTPSink: %s", err)
+	}
+	now := time.Now()
+	var events []rdstail.Event
+	for _, msg := range []string{"one", "two", "three"} {
+		events = append(events, rdstail.Event{
+			Instance: rdstail.Instance{ID: testDB},
+			LogFile:  "error/postgresql.log.00",
+			Event:    parser.Event{Time: now, Message: msg},
+		})
+	}
+	if err := sink.Send(events); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(batches) != 2 || len(batches[0]) != 2 || len(batches[1]) != 1 {
+		t.Fatalf("got batches %v by the time Send returned, want 2 events then 1", batches)
+	}
+	if batches[1][0].Message != "three" || batches[1][0].Instance != testDB {
+		t.Errorf("got %#v, want the third event", batches[1][0])
+	}
+}
+
+func TestHTTPSinkDropsOnlyRejectedBatches(t *testing.T) {
+	for _, tc := range []struct {
+		status int
+		fails  bool
+	}{
+		{http.StatusBadRequest, false},
+		{http.StatusRequestEntityTooLarge, false},
+		{http.StatusUnprocessableEntity, false},
+		{http.StatusUnauthorized, true},
+		{http.StatusForbidden, true},
+		{http.StatusProxyAuthRequired, true},
+		{http.StatusNotFound, true},
+		{http.StatusMethodNotAllowed, true},
+	} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, http.StatusText(tc.status), tc.status)
+		}))
+		sink, err := rdstail.NewHTTPSink(rdstail.HTTPConfig{URL: srv.URL})
+		if err != nil {
+			t.Fatalf("NewHTTPSink: %s", err)
+		}
+		err = sink.Send([]rdstail.Event{{
+			Instance: rdstail.Instance{ID: testDB},
+			LogFile:  "error/postgresql.log.00",
+			Event:    parser.Event{Time: time.Now(), Message: "turned away"},
+		}})
+		if tc.fails && err == nil {
+			t.Errorf("Send answered with %d succeeded, want the events kept for another try", tc.status)
+		} else if !tc.fails && err != nil {
+			t.Errorf("Send answered with %d: %s, want the events dropped", tc.status, err)
+		}
+		srv.Close()
+	}
+}
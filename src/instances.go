@@ -0,0 +1,480 @@
+package rdstail
+
+import (
+	"fmt"
+	"log"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// watchRestartWait is how long WatchInstances waits before restarting the
+// Watch of an instance that failed.
+const watchRestartWait = 10 * time.Second
+
+// InstanceSource is the subset of the RDS API used to find db instances.
+type InstanceSource interface {
+	DescribeDBInstancesPages(*rds.DescribeDBInstancesInput, func(*rds.DescribeDBInstancesOutput, bool) bool) error
+	ListTagsForResource(*rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error)
+	DescribeDBClustersPages(*rds.DescribeDBClustersInput, func(*rds.DescribeDBClustersOutput, bool) bool) error
+}
+
+// Client is the part of the RDS API rdstail uses.  *rds.RDS satisfies it.
+type Client interface {
+	LogSource
+	InstanceSource
+}
+
+// Aurora cluster member roles.
+const (
+	RoleWriter = "writer"
+	RoleReader = "reader"
+)
+
+// Instance is a db instance picked by an InstanceSelector.
+type Instance struct {
+	ID     string
+	Engine string
+	// Cluster and Role are only set for instances picked as cluster members.
+	Cluster string
+	Role    string
+}
+
+// Label names the instance for output, along with its cluster role if any.
+func (i Instance) Label() string {
+	if i.Role == "" {
+		return i.ID
+	}
+	return i.ID + "/" + i.Role
+}
+
+// InstanceSelector picks the db instances to watch.
+type InstanceSelector struct {
+	// Names of instances.  Each entry may hold several comma separated names,
+	// and each name may be a glob (prod-*) or a regular expression between
+	// slashes (/^prod-\d+$/).
+	Names []string
+	// Tags the instances must carry.  An empty value matches any value.
+	Tags map[string]string
+	// Engines the instances must run, e.g. postgres or aurora-*.
+	Engines []string
+	// Clusters whose members are all picked, in addition to the above.
+	// Entries are treated like Names.
+	Clusters []string
+	// LookupEngines has instances named outright listed for their engine,
+	// which only sinks have any use for.
+	LookupEngines bool
+}
+
+func describeInstances(r InstanceSource) (instances []*rds.DBInstance, err error) {
+	err = r.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{}, func(p *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		instances = append(instances, p.DBInstances...)
+		return true
+	})
+	return
+}
+
+func describeClusters(r InstanceSource) (clusters []*rds.DBCluster, err error) {
+	err = r.DescribeDBClustersPages(&rds.DescribeDBClustersInput{}, func(p *rds.DescribeDBClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, p.DBClusters...)
+		return true
+	})
+	return
+}
+
+// clusterMembers returns the members of the clusters matching names, tagged
+// with their current role.
+func clusterMembers(r InstanceSource, names []string) ([]Instance, error) {
+	_, patterns, err := compilePatterns(patternsOnly(names))
+	if err != nil {
+		return nil, err
+	}
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	clusters, err := describeClusters(r)
+	if err != nil {
+		return nil, err
+	}
+	var members []Instance
+	for _, c := range clusters {
+		id := aws.StringValue(c.DBClusterIdentifier)
+		if !matchAny(patterns, id) {
+			continue
+		}
+		for _, m := range c.DBClusterMembers {
+			role := RoleReader
+			if aws.BoolValue(m.IsClusterWriter) {
+				role = RoleWriter
+			}
+			members = append(members, Instance{
+				ID:      aws.StringValue(m.DBInstanceIdentifier),
+				Engine:  aws.StringValue(c.Engine),
+				Cluster: id,
+				Role:    role,
+			})
+		}
+	}
+	return members, nil
+}
+
+func instanceTags(r InstanceSource, inst *rds.DBInstance) (map[string]string, error) {
+	resp, err := r.ListTagsForResource(&rds.ListTagsForResourceInput{
+		ResourceName: inst.DBInstanceArn,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string, len(resp.TagList))
+	for _, t := range resp.TagList {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	return tags, nil
+}
+
+// isPattern reports whether s is a /regex/ or a glob rather than a plain
+// instance name.
+func isPattern(s string) bool {
+	return isRegexp(s) || strings.ContainsAny(s, "*?[")
+}
+
+func isRegexp(s string) bool {
+	return len(s) > 2 && strings.HasPrefix(s, "/") && strings.HasSuffix(s, "/")
+}
+
+func compilePattern(s string) (func(string) bool, error) {
+	if isRegexp(s) {
+		re, err := regexp.Compile(s[1 : len(s)-1])
+		if err != nil {
+			return nil, err
+		}
+		return re.MatchString, nil
+	}
+	if _, err := path.Match(s, ""); err != nil {
+		return nil, fmt.Errorf("bad pattern %q: %s", s, err)
+	}
+	return func(name string) bool {
+		ok, _ := path.Match(s, name)
+		return ok
+	}, nil
+}
+
+func compilePatterns(entries []string) (names []string, patterns []func(string) bool, err error) {
+	for _, entry := range entries {
+		for _, name := range strings.Split(entry, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			if !isPattern(name) {
+				names = append(names, name)
+				continue
+			}
+			match, err := compilePattern(name)
+			if err != nil {
+				return nil, nil, err
+			}
+			patterns = append(patterns, match)
+		}
+	}
+	return
+}
+
+func matchAny(patterns []func(string) bool, s string) bool {
+	for _, match := range patterns {
+		if match(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Empty reports whether s has nothing to select instances by.
+func (s InstanceSelector) Empty() bool {
+	for _, name := range append(s.Names, s.Clusters...) {
+		if strings.Trim(name, ", ") != "" {
+			return false
+		}
+	}
+	return len(s.Tags) == 0 && len(s.Engines) == 0
+}
+
+// Multi reports whether s may select more than one instance.
+func (s InstanceSelector) Multi() bool {
+	names, patterns, err := compilePatterns(s.Names)
+	if err != nil {
+		return true
+	}
+	return len(names) != 1 || len(patterns) > 0 || len(s.Tags) > 0 || len(s.Engines) > 0 || len(s.Clusters) > 0
+}
+
+// Select returns the instances matching s.  Instances named outright are taken
+// as given unless tags or engines have to be checked, everything else is
+// matched against DescribeDBInstances.  Cluster members come first and are
+// found through DescribeDBClusters.
+func (s InstanceSelector) Select(r InstanceSource) ([]Instance, error) {
+	names, patterns, err := compilePatterns(s.Names)
+	if err != nil {
+		return nil, err
+	}
+	_, engines, err := compilePatterns(patternsOnly(s.Engines))
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []Instance
+	seen := make(map[string]bool)
+	add := func(inst Instance) {
+		if !seen[inst.ID] {
+			seen[inst.ID] = true
+			selected = append(selected, inst)
+		}
+	}
+
+	members, err := clusterMembers(r, s.Clusters)
+	if err != nil {
+		return nil, err
+	}
+	for _, inst := range members {
+		add(inst)
+	}
+
+	filtered := len(s.Tags) > 0 || len(engines) > 0
+	if !filtered {
+		var engineOf map[string]string
+		if s.LookupEngines && len(names) > 0 {
+			engineOf = lookupEngines(r)
+		}
+		for _, name := range names {
+			add(Instance{ID: name, Engine: engineOf[name]})
+		}
+		if len(patterns) == 0 {
+			return selected, nil
+		}
+	}
+
+	named := make(map[string]bool, len(names))
+	for _, name := range names {
+		named[name] = true
+	}
+
+	instances, err := describeInstances(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, inst := range instances {
+		id := aws.StringValue(inst.DBInstanceIdentifier)
+		engine := aws.StringValue(inst.Engine)
+		if seen[id] {
+			continue
+		}
+		if len(names)+len(patterns) > 0 && !named[id] && !matchAny(patterns, id) {
+			continue
+		}
+		if len(engines) > 0 && !matchAny(engines, engine) {
+			continue
+		}
+		if len(s.Tags) > 0 {
+			tags, err := instanceTags(r, inst)
+			if err != nil {
+				return nil, err
+			}
+			if !hasTags(tags, s.Tags) {
+				continue
+			}
+		}
+		add(Instance{ID: id, Engine: engine})
+	}
+	return selected, nil
+}
+
+// lookupEngines maps the id of every instance to its engine.  Not knowing
+// the engine is no reason to stop, so failing to list the instances is only
+// logged.
+func lookupEngines(r InstanceSource) map[string]string {
+	instances, err := describeInstances(r)
+	if err != nil {
+		log.Printf("listing instances for their engine: %s", err)
+		return nil
+	}
+	engineOf := make(map[string]string, len(instances))
+	for _, inst := range instances {
+		engineOf[aws.StringValue(inst.DBInstanceIdentifier)] = aws.StringValue(inst.Engine)
+	}
+	return engineOf
+}
+
+// patternsOnly turns every entry into a pattern, so plain names are matched
+// exactly rather than used as given.
+func patternsOnly(entries []string) []string {
+	var out []string
+	for _, entry := range entries {
+		for _, s := range strings.Split(entry, ",") {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				continue
+			}
+			if !isPattern(s) {
+				s = "/^" + regexp.QuoteMeta(s) + "$/"
+			}
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func hasTags(tags, want map[string]string) bool {
+	for k, v := range want {
+		got, ok := tags[k]
+		if !ok || (v != "" && got != v) {
+			return false
+		}
+	}
+	return true
+}
+
+// LinePrefix builds the prefix that tells apart lines of different instances
+// (if instances) and different log files (if files).
+func LinePrefix(inst Instance, logFile string, instances, files bool) string {
+	var parts []string
+	if instances {
+		parts = append(parts, inst.Label())
+	}
+	if files {
+		parts = append(parts, logFile)
+	}
+	return strings.Join(parts, " ") + ": "
+}
+
+// PrefixLines puts prefix in front of every line in lines.
+func PrefixLines(prefix, lines string) string {
+	trailing := strings.HasSuffix(lines, "\n")
+	lines = strings.TrimSuffix(lines, "\n")
+	lines = prefix + strings.Replace(lines, "\n", "\n"+prefix, -1)
+	if trailing {
+		lines += "\n"
+	}
+	return lines
+}
+
+// WatchInstances runs a Watch for each instance picked by sel concurrently.
+// Each pattern in patterns gets a Watch of its own, following the log files
+// it matches independently of the others.  With no patterns, every file of
+// the instance is followed.  If refresh is non-zero the selection is redone
+// on that interval, starting Watches for new instances and stopping those of
+// instances that are gone.
+//
+// Calls to callback are serialized and carry the instance and log file the
+// lines came from.  An instance whose Watch fails is logged and restarted
+// from where it stopped, without affecting the others.  It returns once stop
+// is closed and every Watch has finished.
+func WatchInstances(r Client, sel InstanceSelector, patterns []string, refresh, rate time.Duration, store CheckpointStore, callback func(inst Instance, logFile, lines string) error, stop <-chan struct{}) error {
+	instances, err := sel.Select(r)
+	if err != nil {
+		return err
+	}
+	if len(instances) == 0 && refresh == 0 {
+		return fmt.Errorf("no instances to watch")
+	}
+	if store == nil {
+		// Still remember positions in memory, so restarts don't skip anything
+		store = newMemoryCheckpointStore()
+	}
+	if len(patterns) == 0 {
+		patterns = []string{""}
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	type running struct {
+		inst Instance
+		stop chan struct{}
+	}
+	watching := make(map[string]*running)
+
+	start := func(w *running, pattern string) {
+		defer wg.Done()
+		db := w.inst.ID
+		name := db
+		if pattern != "" {
+			name += " " + pattern
+		}
+		for {
+			err := Watch(r, db, pattern, rate, store, func(logFile, lines string) error {
+				mu.Lock()
+				defer mu.Unlock()
+				return callback(w.inst, logFile, lines)
+			}, w.stop)
+			if err == nil {
+				return
+			}
+
+			log.Printf("%s: %s, restarting in %s", name, err, watchRestartWait)
+			select {
+			case <-time.After(watchRestartWait):
+			case <-w.stop:
+				return
+			}
+		}
+	}
+
+	update := func(instances []Instance) {
+		mu.Lock()
+		defer mu.Unlock()
+		current := make(map[string]bool, len(instances))
+		for _, inst := range instances {
+			current[inst.ID] = true
+			if w, ok := watching[inst.ID]; ok {
+				w.inst = inst
+				continue
+			}
+			w := &running{inst: inst, stop: make(chan struct{})}
+			watching[inst.ID] = w
+			for _, pattern := range patterns {
+				wg.Add(1)
+				go start(w, pattern)
+			}
+		}
+		for id, w := range watching {
+			if !current[id] {
+				log.Printf("%s: no longer selected, stopping", id)
+				close(w.stop)
+				delete(watching, id)
+			}
+		}
+	}
+
+	update(instances)
+
+	var tick <-chan time.Time
+	if refresh > 0 {
+		t := time.NewTicker(refresh)
+		defer t.Stop()
+		tick = t.C
+	}
+	for {
+		select {
+		case <-tick:
+			instances, err := sel.Select(r)
+			if err != nil {
+				log.Printf("refreshing instances: %s", err)
+				continue
+			}
+			update(instances)
+		case <-stop:
+			mu.Lock()
+			for _, w := range watching {
+				close(w.stop)
+			}
+			mu.Unlock()
+			wg.Wait()
+			return nil
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package rdstail_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/parser"
+	"github.com/litl/rdstail/src/rdstailtest"
+)
+
+// listingSource counts DescribeDBInstances calls, failing them with err.
+type listingSource struct {
+	*rdstailtest.LogSource
+	listings int
+	err      error
+}
+
+func (s *listingSource) DescribeDBInstancesPages(req *rds.DescribeDBInstancesInput, fn func(*rds.DescribeDBInstancesOutput, bool) bool) error {
+	s.listings++
+	if s.err != nil {
+		return s.err
+	}
+	return s.LogSource.DescribeDBInstancesPages(req, fn)
+}
+
+func checkSelected(t *testing.T, got []rdstail.Instance, want ...rdstail.Instance) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("selected %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("selected %v, want %v", got[i], want[i])
+		}
+	}
+}
+
+func TestSelectLooksUpNamedInstances(t *testing.T) {
+	src := &listingSource{LogSource: rdstailtest.NewLogSource()}
+	src.AddInstance("prod-db")
+	src.SetEngine("prod-db", "postgres")
+	src.AddInstance("prod-mysql")
+	src.SetEngine("prod-mysql", "mysql")
+	names := []string{"prod-db,prod-mysql,gone"}
+
+	// Without a sink to label, names are taken as given
+	instances, err := rdstail.InstanceSelector{Names: names}.Select(src)
+	if err != nil {
+		t.Fatalf("Select: %s", err)
+	}
+	checkSelected(t, instances, rdstail.Instance{ID: "prod-db"}, rdstail.Instance{ID: "prod-mysql"}, rdstail.Instance{ID: "gone"})
+	if src.listings != 0 {
+		t.Errorf("listed the instances %d times, want none", src.listings)
+	}
+
+	// One listing finds the engine of them all
+	instances, err = rdstail.InstanceSelector{Names: names, LookupEngines: true}.Select(src)
+	if err != nil {
+		t.Fatalf("Select: %s", err)
+	}
+	checkSelected(t, instances, rdstail.Instance{ID: "prod-db", Engine: "postgres"}, rdstail.Instance{ID: "prod-mysql", Engine: "mysql"}, rdstail.Instance{ID: "gone"})
+	if src.listings != 1 {
+		t.Errorf("listed the instances %d times, want once", src.listings)
+	}
+
+	// Failing to list them costs only the engine
+	src.err = errors.New("access denied")
+	instances, err = rdstail.InstanceSelector{Names: names, LookupEngines: true}.Select(src)
+	if err != nil {
+		t.Fatalf("Select: %s", err)
+	}
+	checkSelected(t, instances, rdstail.Instance{ID: "prod-db"}, rdstail.Instance{ID: "prod-mysql"}, rdstail.Instance{ID: "gone"})
+}
+
+func TestSelectByTagsAndEngines(t *testing.T) {
+	src := rdstailtest.NewLogSource()
+	for _, inst := range []struct {
+		id, engine string
+		tags       map[string]string
+	}{
+		{"app-pg", "postgres", map[string]string{"env": "prod", "team": "app"}},
+		{"app-mysql", "mysql", map[string]string{"env": "prod"}},
+		{"dev-pg", "postgres", map[string]string{"env": "dev"}},
+		{"aurora-1", "aurora-postgresql", map[string]string{"env": "prod"}},
+	} {
+		src.AddInstance(inst.id)
+		src.SetEngine(inst.id, inst.engine)
+		src.SetTags(inst.id, inst.tags)
+	}
+
+	for _, tc := range []struct {
+		sel  rdstail.InstanceSelector
+		want []string
+	}{
+		{rdstail.InstanceSelector{Tags: map[string]string{"env": "prod"}}, []string{"app-mysql", "app-pg", "aurora-1"}},
+		// An empty value only asks for the tag to be there
+		{rdstail.InstanceSelector{Tags: map[string]string{"team": ""}}, []string{"app-pg"}},
+		{rdstail.InstanceSelector{Engines: []string{"postgres"}}, []string{"app-pg", "dev-pg"}},
+		{rdstail.InstanceSelector{Engines: []string{"aurora-*", "mysql"}}, []string{"app-mysql", "aurora-1"}},
+		{rdstail.InstanceSelector{Tags: map[string]string{"env": "prod"}, Engines: []string{"postgres"}}, []string{"app-pg"}},
+		// Names are checked against the tags and engines like the rest
+		{rdstail.InstanceSelector{Names: []string{"app-pg,dev-pg,app-mysql"}, Engines: []string{"postgres"}}, []string{"app-pg", "dev-pg"}},
+		{rdstail.InstanceSelector{Names: []string{"app-*"}, Tags: map[string]string{"env": "prod"}}, []string{"app-mysql", "app-pg"}},
+	} {
+		instances, err := tc.sel.Select(src)
+		if err != nil {
+			t.Fatalf("Select %+v: %s", tc.sel, err)
+		}
+		var got []string
+		for _, inst := range instances {
+			got = append(got, inst.ID)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Errorf("Select %+v picked %v, want %v", tc.sel, got, tc.want)
+		}
+	}
+}
+
+func TestSelectClusterRoles(t *testing.T) {
+	src := rdstailtest.NewLogSource()
+	src.SetCluster("aurora", "aurora-postgresql", "aurora-1", "aurora-2", "aurora-3")
+	src.AddInstance("other")
+
+	instances, err := rdstail.InstanceSelector{Clusters: []string{"aurora"}, Names: []string{"other"}}.Select(src)
+	if err != nil {
+		t.Fatalf("Select: %s", err)
+	}
+	checkSelected(t, instances,
+		rdstail.Instance{ID: "aurora-1", Engine: "aurora-postgresql", Cluster: "aurora", Role: rdstail.RoleWriter},
+		rdstail.Instance{ID: "aurora-2", Engine: "aurora-postgresql", Cluster: "aurora", Role: rdstail.RoleReader},
+		rdstail.Instance{ID: "aurora-3", Engine: "aurora-postgresql", Cluster: "aurora", Role: rdstail.RoleReader},
+		rdstail.Instance{ID: "other"})
+}
+
+// fleetSource counts each instance's log downloads, and cluster listings.
+type fleetSource struct {
+	*rdstailtest.LogSource
+
+	mu        sync.Mutex
+	downloads map[string]int
+	listings  int
+}
+
+func (s *fleetSource) DownloadDBLogFilePortionPages(req *rds.DownloadDBLogFilePortionInput, fn func(*rds.DownloadDBLogFilePortionOutput, bool) bool) error {
+	defer func() {
+		s.mu.Lock()
+		s.downloads[aws.StringValue(req.DBInstanceIdentifier)]++
+		s.mu.Unlock()
+	}()
+	return s.LogSource.DownloadDBLogFilePortionPages(req, fn)
+}
+
+func (s *fleetSource) DescribeDBClustersPages(req *rds.DescribeDBClustersInput, fn func(*rds.DescribeDBClustersOutput, bool) bool) error {
+	s.mu.Lock()
+	s.listings++
+	s.mu.Unlock()
+	return s.LogSource.DescribeDBClustersPages(req, fn)
+}
+
+func (s *fleetSource) downloaded(db string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.downloads[db]
+}
+
+// waitRefresh waits for a selection started after the call, and so the
+// update of the Watches before it, to be done.
+func (s *fleetSource) waitRefresh(t *testing.T) {
+	t.Helper()
+	s.mu.Lock()
+	n := s.listings
+	s.mu.Unlock()
+	waitUntil(t, "a refresh", func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.listings >= n+2
+	})
+}
+
+func TestWatchInstancesRefresh(t *testing.T) {
+	src := &fleetSource{LogSource: rdstailtest.NewLogSource(), downloads: make(map[string]int)}
+	src.SetCluster("aurora", "aurora-postgresql", "aurora-1", "aurora-2")
+	src.Append("aurora-1", "error/postgresql.log.00", "old\n")
+	src.Append("aurora-2", "error/postgresql.log.00", "old\n")
+
+	var mu sync.Mutex
+	got := make(map[string][]string) // lines by instance label
+	sel := rdstail.InstanceSelector{Clusters: []string{"aurora"}, Names: []string{"extra-*"}}
+	stop := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- rdstail.WatchInstances(src, sel, nil, 5*time.Millisecond, testRate, nil, func(inst rdstail.Instance, logFile, lines string) error {
+			if inst.Engine != "aurora-postgresql" && inst.Cluster != "" {
+				t.Errorf("%s has engine %q, want its cluster's", inst.ID, inst.Engine)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if lines != "" {
+				got[inst.Label()] = append(got[inst.Label()], strings.TrimSuffix(lines, "\n"))
+			}
+			return nil
+		}, stop)
+	}()
+	// write appends a line to db, once its Watch has got past the end of the
+	// file, and waits for it to be called back with label
+	write := func(db, label, line string) {
+		t.Helper()
+		waitUntil(t, db+" to be watched", func() bool { return src.downloaded(db) > 0 })
+		src.Append(db, "error/postgresql.log.00", line+"\n")
+		waitUntil(t, line, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			lines := got[label]
+			return len(lines) > 0 && lines[len(lines)-1] == line
+		})
+	}
+
+	write("aurora-1", "aurora-1/writer", "first write")
+	write("aurora-2", "aurora-2/reader", "first read")
+
+	// A failover swaps the roles of the Watches already running
+	src.SetCluster("aurora", "aurora-postgresql", "aurora-2", "aurora-1")
+	src.waitRefresh(t)
+	write("aurora-2", "aurora-2/writer", "second write")
+	write("aurora-1", "aurora-1/reader", "second read")
+
+	// New instances are picked up, and gone ones let go of
+	src.Append("extra-1", "error/postgresql.log.00", "old\n")
+	write("extra-1", "extra-1", "extra line")
+	src.RemoveInstance("extra-1")
+	src.waitRefresh(t)
+	// Had its Watch been kept, waiting to restart, coming back wouldn't
+	// start another
+	n := src.downloaded("extra-1")
+	src.Append("extra-1", "error/postgresql.log.00", "old\n")
+	waitUntil(t, "extra-1 to be watched again", func() bool { return src.downloaded("extra-1") > n })
+	write("extra-1", "extra-1", "extra again")
+
+	close(stop)
+	if err := <-done; err != nil {
+		t.Fatalf("WatchInstances: %s", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	want := map[string][]string{
+		"aurora-1/writer": {"first write"},
+		"aurora-2/reader": {"first read"},
+		"aurora-2/writer": {"second write"},
+		"aurora-1/reader": {"second read"},
+		"extra-1":         {"extra line", "extra again"},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got lines %v, want %v", got, want)
+	}
+}
+
+func TestLokiEngineLabelOfNamedInstance(t *testing.T) {
+	src := rdstailtest.NewLogSource()
+	src.AddInstance("prod-db")
+	src.SetEngine("prod-db", "postgres")
+	instances, err := rdstail.InstanceSelector{Names: []string{"prod-db"}, LookupEngines: true}.Select(src)
+	if err != nil || len(instances) != 1 {
+		t.Fatalf("Select: %v %s", instances, err)
+	}
+
+	var mu sync.Mutex
+	var labels []map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Streams []struct {
+				Stream map[string]string `json:"stream"`
+			} `json:"streams"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, st := range req.Streams {
+			labels = append(labels, st.Stream)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	sink, err := rdstail.NewLokiSink(rdstail.LokiConfig{URL: srv.URL, Format: "json"})
+	if err != nil {
+		t.Fatalf("NewLokiSink: %s", err)
+	}
+	err = sink.Send([]rdstail.Event{{
+		Instance: instances[0],
+		LogFile:  "error/postgresql.log.00",
+		Event:    parser.Event{Time: time.Now(), Severity: "ERROR", Raw: "oops"},
+	}})
+	if err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(labels) != 1 || labels[0]["engine"] != "postgres" || labels[0]["instance"] != "prod-db" {
+		t.Errorf("pushed streams labelled %v, want engine postgres and instance prod-db", labels)
+	}
+}
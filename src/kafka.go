@@ -0,0 +1,174 @@
+package rdstail
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+// KafkaMessage is a message to publish to a Kafka topic.
+type KafkaMessage struct {
+	Topic string
+	Key   []byte
+	Value []byte
+}
+
+// KafkaProducer publishes messages to Kafka, returning once they are all
+// acknowledged.  NewKafkaProducer makes one for a real cluster, and
+// rdstailtest.KafkaBroker is an in-memory fake.
+type KafkaProducer interface {
+	SendMessages(msgs []*KafkaMessage) error
+	Close() error
+}
+
+// KafkaConfig describes a Kafka cluster and how to produce to it.
+type KafkaConfig struct {
+	Brokers []string
+	// Compression is none, gzip, snappy, lz4 or zstd
+	Compression string
+	// Acks is none, leader or all
+	Acks string
+	// Messages are sent once BatchSize of them are waiting, or BatchAge has
+	// passed
+	BatchSize int
+	BatchAge  time.Duration
+	TLS       bool
+	// CAFile holds the PEM certificates to verify the brokers with, instead
+	// of the system's
+	CAFile string
+	// SASLUser and SASLPassword authenticate with SASL/PLAIN
+	SASLUser     string
+	SASLPassword string
+}
+
+var kafkaCompression = map[string]sarama.CompressionCodec{
+	"none":   sarama.CompressionNone,
+	"gzip":   sarama.CompressionGZIP,
+	"snappy": sarama.CompressionSnappy,
+	"lz4":    sarama.CompressionLZ4,
+	"zstd":   sarama.CompressionZSTD,
+}
+
+var kafkaAcks = map[string]sarama.RequiredAcks{
+	"none":   sarama.NoResponse,
+	"leader": sarama.WaitForLocal,
+	"all":    sarama.WaitForAll,
+}
+
+type saramaProducer struct {
+	p sarama.SyncProducer
+}
+
+// NewKafkaProducer connects to the cluster described by cfg.
+func NewKafkaProducer(cfg KafkaConfig) (KafkaProducer, error) {
+	sc := sarama.NewConfig()
+	sc.ClientID = "rdstail"
+	sc.Producer.Return.Successes = true
+	// One request in flight at a time so retries can't reorder an instance's
+	// messages
+	sc.Net.MaxOpenRequests = 1
+
+	if cfg.Compression == "" {
+		cfg.Compression = "none"
+	}
+	codec, ok := kafkaCompression[cfg.Compression]
+	if !ok {
+		return nil, fmt.Errorf("unknown kafka compression %q, expected none, gzip, snappy, lz4 or zstd", cfg.Compression)
+	}
+	sc.Producer.Compression = codec
+	if codec == sarama.CompressionZSTD {
+		sc.Version = sarama.V2_1_0_0
+	}
+
+	if cfg.Acks == "" {
+		cfg.Acks = "all"
+	}
+	acks, ok := kafkaAcks[cfg.Acks]
+	if !ok {
+		return nil, fmt.Errorf("unknown kafka acks %q, expected none, leader or all", cfg.Acks)
+	}
+	sc.Producer.RequiredAcks = acks
+
+	sc.Producer.Flush.Messages = cfg.BatchSize
+	sc.Producer.Flush.Frequency = cfg.BatchAge
+
+	if cfg.TLS {
+		tlsConfig, err := loadTLSConfig(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		sc.Net.TLS.Enable = true
+		sc.Net.TLS.Config = tlsConfig
+	} else if cfg.CAFile != "" {
+		return nil, fmt.Errorf("kafka ca file given without tls")
+	}
+	if cfg.SASLUser != "" {
+		sc.Net.SASL.Enable = true
+		sc.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		sc.Net.SASL.User = cfg.SASLUser
+		sc.Net.SASL.Password = cfg.SASLPassword
+	}
+
+	p, err := sarama.NewSyncProducer(cfg.Brokers, sc)
+	if err != nil {
+		return nil, err
+	}
+	return &saramaProducer{p: p}, nil
+}
+
+func (p *saramaProducer) SendMessages(msgs []*KafkaMessage) error {
+	pms := make([]*sarama.ProducerMessage, len(msgs))
+	for i, m := range msgs {
+		pms[i] = &sarama.ProducerMessage{
+			Topic: m.Topic,
+			Key:   sarama.ByteEncoder(m.Key),
+			Value: sarama.ByteEncoder(m.Value),
+		}
+	}
+	err := p.p.SendMessages(pms)
+	if errs, ok := err.(sarama.ProducerErrors); ok && len(errs) > 0 {
+		return fmt.Errorf("kafka: %d of %d messages failed, first: %s", len(errs), len(msgs), errs[0].Err)
+	}
+	return err
+}
+
+func (p *saramaProducer) Close() error {
+	return p.p.Close()
+}
+
+// KafkaSink publishes events as JSON documents, keyed by instance so each
+// instance's events stay in order on one partition.
+type KafkaSink struct {
+	producer KafkaProducer
+	topic    string
+}
+
+// NewKafkaSink publishes to the topic named by expanding topic for each
+// event, as ExpandTemplate does.
+func NewKafkaSink(producer KafkaProducer, topic string) *KafkaSink {
+	return &KafkaSink{producer: producer, topic: topic}
+}
+
+func (s *KafkaSink) Send(events []Event) error {
+	now := time.Now()
+	msgs := make([]*KafkaMessage, 0, len(events))
+	for i := range events {
+		e := &events[i]
+		value, err := json.Marshal(NewDocument(e, now))
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, &KafkaMessage{
+			Topic: ExpandTemplate(s.topic, e),
+			Key:   []byte(e.Instance.ID),
+			Value: value,
+		})
+	}
+	return s.producer.SendMessages(msgs)
+}
+
+func (s *KafkaSink) Close() error {
+	return s.producer.Close()
+}
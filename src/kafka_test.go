@@ -0,0 +1,82 @@
+package rdstail_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/parser"
+	"github.com/litl/rdstail/src/rdstailtest"
+)
+
+func kafkaEvent(id, engine, logFile, msg string) rdstail.Event {
+	return rdstail.Event{
+		Instance: rdstail.Instance{ID: id, Engine: engine},
+		LogFile:  logFile,
+		Event:    parser.Event{Time: time.Now(), Message: msg},
+	}
+}
+
+// checkKafkaMessages checks msgs hold the given messages, in order.
+func checkKafkaMessages(t *testing.T, msgs []rdstail.KafkaMessage, want ...string) {
+	t.Helper()
+	if len(msgs) != len(want) {
+		t.Fatalf("got %d messages, want %d", len(msgs), len(want))
+	}
+	for i, m := range msgs {
+		var doc rdstail.Document
+		if err := json.Unmarshal(m.Value, &doc); err != nil {
+			t.Fatalf("message %d: %s", i, err)
+		}
+		if doc.Message != want[i] || string(m.Key) != doc.Instance {
+			t.Errorf("message %d is %q keyed %q, want %q keyed by its instance %q", i, doc.Message, m.Key, want[i], doc.Instance)
+		}
+	}
+}
+
+func TestKafkaSinkKeysAndTopics(t *testing.T) {
+	broker := rdstailtest.NewKafkaBroker()
+	sink := rdstail.NewKafkaSink(broker, "rds.{engine}.{logtype}")
+	err := sink.Send([]rdstail.Event{
+		kafkaEvent("pg-1", "postgres", "error/postgresql.log.00", "pg-1 first"),
+		kafkaEvent("pg-2", "postgres", "error/postgresql.log.00", "pg-2 first"),
+		kafkaEvent("my-1", "mysql", "slowquery/mysql-slowquery.log", "my-1 slow"),
+		kafkaEvent("pg-1", "postgres", "error/postgresql.log.00", "pg-1 second"),
+	})
+	if err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+
+	checkKafkaMessages(t, broker.Messages("rds.postgres.error"), "pg-1 first", "pg-2 first", "pg-1 second")
+	checkKafkaMessages(t, broker.Keyed("rds.postgres.error", "pg-1"), "pg-1 first", "pg-1 second")
+	checkKafkaMessages(t, broker.Keyed("rds.postgres.error", "pg-2"), "pg-2 first")
+	checkKafkaMessages(t, broker.Messages("rds.mysql.slowquery"), "my-1 slow")
+
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close: %s", err)
+	}
+	if !broker.Closed() {
+		t.Errorf("closing the sink left the producer open")
+	}
+}
+
+func TestKafkaSinkFailure(t *testing.T) {
+	broker := rdstailtest.NewKafkaBroker()
+	sink := rdstail.NewKafkaSink(broker, "rds")
+
+	broker.Fail(errors.New("leader not available"))
+	if err := sink.Send([]rdstail.Event{kafkaEvent("pg-1", "postgres", "error/postgresql.log.00", "lost")}); err == nil {
+		t.Fatalf("Send succeeded while the broker failed")
+	}
+	if msgs := broker.Messages("rds"); len(msgs) != 0 {
+		t.Fatalf("failed Send published %d messages", len(msgs))
+	}
+
+	broker.Fail(nil)
+	if err := sink.Send([]rdstail.Event{kafkaEvent("pg-1", "postgres", "error/postgresql.log.00", "again")}); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+	checkKafkaMessages(t, broker.Messages("rds"), "again")
+}
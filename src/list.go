@@ -0,0 +1,109 @@
+package rdstail
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// LogFile describes a log file of an instance.
+type LogFile struct {
+	Instance         string    `json:"instance"`
+	Name             string    `json:"name"`
+	Size             int64     `json:"size"`
+	LastWritten      time.Time `json:"last_written"`
+	LastWrittenEpoch int64     `json:"last_written_epoch"`
+}
+
+// epochMillis converts t into the epoch milliseconds RDS uses for LastWritten.
+func epochMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func fromEpochMillis(ms int64) time.Time {
+	return time.Unix(ms/1000, (ms%1000)*int64(time.Millisecond)).UTC()
+}
+
+// ListLogFiles returns the log files of db matching pattern that hold lines
+// written between since and until.  A file holds lines from when the one
+// before it in its directory was last written until it was, so past until
+// the first file written in each directory is included as well.  A zero
+// since or until leaves that end open.
+func ListLogFiles(r LogSource, db, pattern string, since, until time.Time) ([]LogFile, error) {
+	var sinceMillis int64
+	if !since.IsZero() {
+		sinceMillis = epochMillis(since)
+	}
+	details, err := describeLogFiles(r, db, pattern, sinceMillis)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first file written after until in each directory
+	after := make(map[string]int64)
+	if !until.IsZero() {
+		untilMillis := epochMillis(until)
+		for _, d := range details {
+			dir := path.Dir(aws.StringValue(d.LogFileName))
+			written := aws.Int64Value(d.LastWritten)
+			if first, ok := after[dir]; written > untilMillis && (!ok || written < first) {
+				after[dir] = written
+			}
+		}
+	}
+
+	var files []LogFile
+	for _, d := range details {
+		name := aws.StringValue(d.LogFileName)
+		written := aws.Int64Value(d.LastWritten)
+		if !until.IsZero() && written > epochMillis(until) && written != after[path.Dir(name)] {
+			continue
+		}
+		files = append(files, LogFile{
+			Instance:         db,
+			Name:             name,
+			Size:             aws.Int64Value(d.Size),
+			LastWritten:      fromEpochMillis(written),
+			LastWrittenEpoch: written,
+		})
+	}
+	return files, nil
+}
+
+// SortLogFiles sorts files by instance and then by "name", "size" or "time".
+func SortLogFiles(files []LogFile, by string, reverse bool) error {
+	var less func(a, b LogFile) bool
+	switch by {
+	case "name":
+		less = func(a, b LogFile) bool { return a.Name < b.Name }
+	case "size":
+		less = func(a, b LogFile) bool { return a.Size < b.Size }
+	case "time":
+		less = func(a, b LogFile) bool { return a.LastWrittenEpoch < b.LastWrittenEpoch }
+	default:
+		return fmt.Errorf("can't sort by %q, expected name, size or time", by)
+	}
+
+	sort.Stable(logFileSorter{files, func(a, b LogFile) bool {
+		if a.Instance != b.Instance {
+			return a.Instance < b.Instance
+		}
+		if reverse {
+			return less(b, a)
+		}
+		return less(a, b)
+	}})
+	return nil
+}
+
+type logFileSorter struct {
+	files []LogFile
+	less  func(a, b LogFile) bool
+}
+
+func (s logFileSorter) Len() int           { return len(s.files) }
+func (s logFileSorter) Swap(i, j int)      { s.files[i], s.files[j] = s.files[j], s.files[i] }
+func (s logFileSorter) Less(i, j int) bool { return s.less(s.files[i], s.files[j]) }
@@ -0,0 +1,60 @@
+package rdstail_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/rdstailtest"
+)
+
+func checkListed(t *testing.T, src *rdstailtest.LogSource, since, until time.Time, want ...string) {
+	t.Helper()
+	files, err := rdstail.ListLogFiles(src, testDB, "", since, until)
+	if err != nil {
+		t.Fatalf("ListLogFiles: %s", err)
+	}
+	var got []string
+	for _, f := range files {
+		got = append(got, f.Name)
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("listed %q, want %q", got, want)
+	}
+}
+
+func TestListLogFilesOverlapping(t *testing.T) {
+	src := rdstailtest.NewLogSource()
+	for _, name := range []string{
+		"error/postgresql.log.00",
+		"error/postgresql.log.01",
+		"slowquery/postgresql.log.00",
+		"error/postgresql.log.02",
+		"slowquery/postgresql.log.01",
+		"error/postgresql.log.03",
+	} {
+		src.Append(testDB, name, "line\n")
+	}
+	ms := src.LastWritten(testDB, "error/postgresql.log.01")
+	then := time.Unix(0, ms*int64(time.Millisecond))
+
+	// What was written up to then went on in the next file of each log
+	checkListed(t, src, time.Time{}, then,
+		"error/postgresql.log.00",
+		"error/postgresql.log.01",
+		"error/postgresql.log.02",
+		"slowquery/postgresql.log.00",
+	)
+	checkListed(t, src, then, then,
+		"error/postgresql.log.01",
+		"error/postgresql.log.02",
+		"slowquery/postgresql.log.00",
+	)
+	checkListed(t, src, then.Add(time.Millisecond), time.Time{},
+		"error/postgresql.log.02",
+		"error/postgresql.log.03",
+		"slowquery/postgresql.log.00",
+		"slowquery/postgresql.log.01",
+	)
+}
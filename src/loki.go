@@ -0,0 +1,248 @@
+package rdstail
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/snappy"
+)
+
+const lokiPushPath = "/loki/api/v1/push"
+
+// LokiConfig describes a Loki server and the labels to send events with.
+type LokiConfig struct {
+	// URL of the server, e.g. http://localhost:3100
+	URL string
+	// Format is protobuf for snappy compressed protobuf, or json
+	Format string
+	// Labels are added to the instance, engine, log_type and severity labels
+	// of every stream
+	Labels   map[string]string
+	Headers  http.Header
+	Username string
+	Password string
+	Client   *http.Client
+}
+
+// LokiSink pushes events to Loki, in a stream for each set of labels.
+type LokiSink struct {
+	cfg LokiConfig
+}
+
+func NewLokiSink(cfg LokiConfig) (*LokiSink, error) {
+	switch cfg.Format {
+	case "":
+		cfg.Format = "protobuf"
+	case "protobuf", "json":
+	default:
+		return nil, fmt.Errorf("unknown loki format %q, expected protobuf or json", cfg.Format)
+	}
+	cfg.URL = strings.TrimSuffix(cfg.URL, "/")
+	if !strings.HasSuffix(cfg.URL, lokiPushPath) {
+		cfg.URL += lokiPushPath
+	}
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+	return &LokiSink{cfg: cfg}, nil
+}
+
+type lokiEntry struct {
+	t    time.Time
+	line string
+}
+
+type lokiStream struct {
+	labels  map[string]string
+	entries []lokiEntry
+}
+
+// labels returns the labels of the stream e goes in.
+func (s *LokiSink) labels(e *Event) map[string]string {
+	labels := map[string]string{
+		"instance": e.Instance.ID,
+		"log_type": LogFamily(e.LogFile),
+	}
+	if e.Instance.Engine != "" {
+		labels["engine"] = e.Instance.Engine
+	}
+	if e.Severity != "" {
+		labels["severity"] = strings.ToLower(e.Severity)
+	}
+	for k, v := range s.cfg.Labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+// lokiLabelString formats labels the way Loki names a stream, e.g.
+// {engine="postgres", instance="prod-db"}.
+func lokiLabelString(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + strconv.Quote(labels[k])
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+func (s *LokiSink) Send(events []Event) error {
+	now := time.Now()
+	var keys []string
+	streams := make(map[string]*lokiStream)
+	for i := range events {
+		e := &events[i]
+		line := e.Raw
+		if line == "" {
+			line = e.Message
+		}
+		if line == "" {
+			continue
+		}
+		labels := s.labels(e)
+		key := lokiLabelString(labels)
+		st, ok := streams[key]
+		if !ok {
+			st = &lokiStream{labels: labels}
+			streams[key] = st
+			keys = append(keys, key)
+		}
+		st.entries = append(st.entries, lokiEntry{t: e.Timestamp(now), line: line})
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	// Loki turns away entries older than the last one of their stream
+	for _, st := range streams {
+		sort.Stable(byEntryTime(st.entries))
+	}
+
+	var body []byte
+	var contentType string
+	if s.cfg.Format == "json" {
+		var err error
+		if body, err = lokiJSON(keys, streams); err != nil {
+			return err
+		}
+		contentType = "application/json"
+	} else {
+		body = snappy.Encode(nil, lokiProtobuf(keys, streams))
+		contentType = "application/x-protobuf"
+	}
+
+	resp, err := doWithRetry(s.cfg.Client, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", s.cfg.URL, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range s.cfg.Headers {
+			req.Header[k] = v
+		}
+		req.Header.Set("Content-Type", contentType)
+		if s.cfg.Username != "" {
+			req.SetBasicAuth(s.cfg.Username, s.cfg.Password)
+		}
+		return req, nil
+	})
+	// Loki turns away the whole push for entries it won't take, too old or
+	// out of order ones, so holding on to them would stop the stream for good
+	if serr, ok := err.(*HTTPStatusError); ok && serr.StatusCode/100 == 4 && !serr.retryable() && !serr.unauthorized() {
+		log.Printf("loki: dropping %d streams: %s", len(keys), err)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	return nil
+}
+
+func (s *LokiSink) Close() error {
+	return nil
+}
+
+type byEntryTime []lokiEntry
+
+func (s byEntryTime) Len() int           { return len(s) }
+func (s byEntryTime) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byEntryTime) Less(i, j int) bool { return s[i].t.Before(s[j].t) }
+
+func lokiJSON(keys []string, streams map[string]*lokiStream) ([]byte, error) {
+	type jsonStream struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	}
+	var req struct {
+		Streams []jsonStream `json:"streams"`
+	}
+	for _, key := range keys {
+		st := streams[key]
+		js := jsonStream{Stream: st.labels}
+		for _, e := range st.entries {
+			js.Values = append(js.Values, [2]string{strconv.FormatInt(e.t.UnixNano(), 10), e.line})
+		}
+		req.Streams = append(req.Streams, js)
+	}
+	return json.Marshal(req)
+}
+
+// lokiProtobuf encodes a logproto.PushRequest:
+//
+//	message PushRequest { repeated Stream streams = 1; }
+//	message Stream { string labels = 1; repeated Entry entries = 2; }
+//	message Entry { google.protobuf.Timestamp timestamp = 1; string line = 2; }
+func lokiProtobuf(keys []string, streams map[string]*lokiStream) []byte {
+	var req []byte
+	for _, key := range keys {
+		var st []byte
+		st = appendProtoBytes(st, 1, []byte(key))
+		for _, e := range streams[key].entries {
+			var ts []byte
+			ts = appendProtoVarint(ts, 1, uint64(e.t.Unix()))
+			if ns := e.t.Nanosecond(); ns != 0 {
+				ts = appendProtoVarint(ts, 2, uint64(ns))
+			}
+			var entry []byte
+			entry = appendProtoBytes(entry, 1, ts)
+			entry = appendProtoBytes(entry, 2, []byte(e.line))
+			st = appendProtoBytes(st, 2, entry)
+		}
+		req = appendProtoBytes(req, 1, st)
+	}
+	return req
+}
+
+func appendVarint(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+// appendProtoVarint appends a varint field to a protobuf message.
+func appendProtoVarint(b []byte, field int, v uint64) []byte {
+	b = appendVarint(b, uint64(field)<<3)
+	return appendVarint(b, v)
+}
+
+// appendProtoBytes appends a length delimited field, a string or an embedded
+// message, to a protobuf message.
+func appendProtoBytes(b []byte, field int, data []byte) []byte {
+	b = appendVarint(b, uint64(field)<<3|2)
+	b = appendVarint(b, uint64(len(data)))
+	return append(b, data...)
+}
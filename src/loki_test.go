@@ -0,0 +1,233 @@
+package rdstail_test
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/parser"
+)
+
+// protoField is a field of an encoded protobuf message.  Varints are in v,
+// length delimited fields in data.
+type protoField struct {
+	num  int
+	v    uint64
+	data []byte
+}
+
+// protoFields splits an encoded protobuf message into its fields.
+func protoFields(t *testing.T, b []byte) []protoField {
+	t.Helper()
+	var fields []protoField
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			t.Fatalf("bad field key")
+		}
+		b = b[n:]
+		f := protoField{num: int(key >> 3)}
+		switch key & 7 {
+		case 0:
+			if f.v, n = binary.Uvarint(b); n <= 0 {
+				t.Fatalf("bad varint in field %d", f.num)
+			}
+			b = b[n:]
+		case 1:
+			if len(b) < 8 {
+				t.Fatalf("short fixed64 in field %d", f.num)
+			}
+			f.v = binary.LittleEndian.Uint64(b)
+			b = b[8:]
+		case 2:
+			size, n := binary.Uvarint(b)
+			if n <= 0 || uint64(len(b)-n) < size {
+				t.Fatalf("bad length of field %d", f.num)
+			}
+			f.data = b[n : n+int(size)]
+			b = b[n+int(size):]
+		default:
+			t.Fatalf("unexpected wire type %d in field %d", key&7, f.num)
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+type lokiEntry struct {
+	sec, nsec uint64
+	line      string
+}
+
+// lokiPush is a decoded logproto.PushRequest, the entries of each stream by
+// its labels, and the labels in the order they came.
+type lokiPush struct {
+	labels  []string
+	entries map[string][]lokiEntry
+}
+
+func decodeLokiPush(t *testing.T, body []byte) lokiPush {
+	t.Helper()
+	push := lokiPush{entries: make(map[string][]lokiEntry)}
+	for _, st := range protoFields(t, body) {
+		if st.num != 1 {
+			t.Fatalf("push request has field %d, want only streams", st.num)
+		}
+		var labels string
+		var entries []lokiEntry
+		for _, f := range protoFields(t, st.data) {
+			switch f.num {
+			case 1:
+				labels = string(f.data)
+			case 2:
+				var e lokiEntry
+				for _, ef := range protoFields(t, f.data) {
+					switch ef.num {
+					case 1:
+						for _, tf := range protoFields(t, ef.data) {
+							switch tf.num {
+							case 1:
+								e.sec = tf.v
+							case 2:
+								e.nsec = tf.v
+							}
+						}
+					case 2:
+						e.line = string(ef.data)
+					}
+				}
+				entries = append(entries, e)
+			default:
+				t.Fatalf("stream has field %d", f.num)
+			}
+		}
+		push.labels = append(push.labels, labels)
+		push.entries[labels] = entries
+	}
+	return push
+}
+
+// lokiServer decodes the pushes it gets, answering each with the next of
+// statuses, or 204 once they run out.
+type lokiServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	pushes   []lokiPush
+}
+
+func newLokiServer(t *testing.T, statuses ...int) *lokiServer {
+	s := &lokiServer{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/loki/api/v1/push" || r.Header.Get("Content-Type") != "application/x-protobuf" {
+			http.Error(w, "unexpected push", http.StatusNotFound)
+			return
+		}
+		compressed, _ := ioutil.ReadAll(r.Body)
+		body, err := snappy.Decode(nil, compressed)
+		if err != nil {
+			t.Errorf("push body isn't snappy compressed: %s", err)
+		}
+		push := decodeLokiPush(t, body)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.pushes = append(s.pushes, push)
+		if len(s.statuses) > 0 {
+			status := s.statuses[0]
+			s.statuses = s.statuses[1:]
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	return s
+}
+
+func (s *lokiServer) received() []lokiPush {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pushes
+}
+
+func lokiEvents(when time.Time) []rdstail.Event {
+	inst := rdstail.Instance{ID: "prod-db", Engine: "postgres"}
+	return []rdstail.Event{
+		{Instance: inst, LogFile: "error/postgresql.log.00", Event: parser.Event{Time: when.Add(time.Second), Severity: "ERROR", Raw: "second error"}},
+		{Instance: inst, LogFile: "error/postgresql.log.00", Event: parser.Event{Time: when, Severity: "LOG", Raw: "a log line"}},
+		{Instance: inst, LogFile: "error/postgresql.log.00", Event: parser.Event{Time: when, Severity: "ERROR", Raw: "first error"}},
+	}
+}
+
+func TestLokiProtobufPush(t *testing.T) {
+	srv := newLokiServer(t)
+	defer srv.Close()
+	sink, err := rdstail.NewLokiSink(rdstail.LokiConfig{URL: srv.URL, Labels: map[string]string{"env": "prod"}})
+	if err != nil {
+		t.Fatalf("NewLokiSink: %s", err)
+	}
+
+	when := time.Date(2020, 1, 1, 10, 0, 0, 500, time.UTC)
+	if err := sink.Send(lokiEvents(when)); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+
+	pushes := srv.received()
+	if len(pushes) != 1 {
+		t.Fatalf("got %d pushes, want 1", len(pushes))
+	}
+	errorLabels := `{engine="postgres", env="prod", instance="prod-db", log_type="error", severity="error"}`
+	logLabels := `{engine="postgres", env="prod", instance="prod-db", log_type="error", severity="log"}`
+	if got := pushes[0].labels; len(got) != 2 || got[0] != errorLabels || got[1] != logLabels {
+		t.Fatalf("got streams %q, want %q and %q", got, errorLabels, logLabels)
+	}
+	sec := uint64(when.Unix())
+	// Entries of a stream go in order of time
+	want := map[string][]lokiEntry{
+		errorLabels: {{sec, 500, "first error"}, {sec + 1, 500, "second error"}},
+		logLabels:   {{sec, 500, "a log line"}},
+	}
+	for labels, entries := range want {
+		got := pushes[0].entries[labels]
+		if len(got) != len(entries) {
+			t.Fatalf("stream %s has entries %v, want %v", labels, got, entries)
+		}
+		for i := range entries {
+			if got[i] != entries[i] {
+				t.Errorf("stream %s entry %d is %v, want %v", labels, i, got[i], entries[i])
+			}
+		}
+	}
+}
+
+func TestLokiDropsRejectedPushes(t *testing.T) {
+	srv := newLokiServer(t, http.StatusBadRequest, http.StatusUnauthorized)
+	defer srv.Close()
+	sink, err := rdstail.NewLokiSink(rdstail.LokiConfig{URL: srv.URL})
+	if err != nil {
+		t.Fatalf("NewLokiSink: %s", err)
+	}
+	when := time.Now()
+
+	// Entries Loki won't take are dropped, so they don't hold up the rest
+	if err := sink.Send(lokiEvents(when)); err != nil {
+		t.Errorf("Send of entries loki turned away: %s", err)
+	}
+	// Bad credentials fail every push alike, so the events are kept
+	if err := sink.Send(lokiEvents(when)); err == nil {
+		t.Errorf("Send succeeded while unauthorized")
+	}
+	if err := sink.Send(lokiEvents(when)); err != nil {
+		t.Errorf("Send: %s", err)
+	}
+	if n := len(srv.received()); n != 3 {
+		t.Errorf("got %d pushes, want 3", n)
+	}
+}
@@ -0,0 +1,405 @@
+package rdstail
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+const (
+	otlpLogsPath     = "/v1/logs"
+	otlpExportMethod = "/opentelemetry.proto.collector.logs.v1.LogsService/Export"
+	otlpBatchSize    = 1000
+	otlpDialTimeout  = 30 * time.Second
+)
+
+// OTLPConfig describes an OpenTelemetry collector to export events to.
+type OTLPConfig struct {
+	// Protocol is http for OTLP/HTTP with protobuf, or grpc
+	Protocol string
+	// URL of the collector, e.g. http://localhost:4318 for http or
+	// http://localhost:4317 for grpc.  Use https for TLS.
+	URL     string
+	Headers http.Header
+	Gzip    bool
+	// Region is recorded as the cloud.region of every instance
+	Region string
+	Client *http.Client
+}
+
+// OTLPSink exports events as OTLP log records, with a resource for each
+// instance.
+type OTLPSink struct {
+	cfg OTLPConfig
+}
+
+func NewOTLPSink(cfg OTLPConfig) (*OTLPSink, error) {
+	switch cfg.Protocol {
+	case "":
+		cfg.Protocol = "http"
+	case "http", "grpc":
+	default:
+		return nil, fmt.Errorf("unknown otlp protocol %q, expected http or grpc", cfg.Protocol)
+	}
+	if cfg.URL == "" {
+		cfg.URL = "http://localhost:4318"
+		if cfg.Protocol == "grpc" {
+			cfg.URL = "http://localhost:4317"
+		}
+	} else if !strings.Contains(cfg.URL, "://") {
+		cfg.URL = "http://" + cfg.URL
+	}
+	cfg.URL = strings.TrimSuffix(cfg.URL, "/")
+
+	if cfg.Protocol == "http" {
+		if !strings.HasSuffix(cfg.URL, otlpLogsPath) {
+			cfg.URL += otlpLogsPath
+		}
+		if cfg.Client == nil {
+			cfg.Client = http.DefaultClient
+		}
+	} else {
+		cfg.URL += otlpExportMethod
+		if cfg.Client == nil {
+			t := &http2.Transport{}
+			if strings.HasPrefix(cfg.URL, "http://") {
+				// gRPC without TLS is HTTP/2 over cleartext
+				t.AllowHTTP = true
+				t.DialTLS = func(network, addr string, _ *tls.Config) (net.Conn, error) {
+					return net.DialTimeout(network, addr, otlpDialTimeout)
+				}
+			}
+			cfg.Client = &http.Client{Transport: t}
+		}
+	}
+	return &OTLPSink{cfg: cfg}, nil
+}
+
+// GRPCStatusError is a gRPC call that ended with a status other than OK.
+type GRPCStatusError struct {
+	URL     string
+	Code    int
+	Message string
+}
+
+func (e *GRPCStatusError) Error() string {
+	return fmt.Sprintf("%s: grpc status %d: %s", e.URL, e.Code, e.Message)
+}
+
+// retryable tells whether the collector might take the export if tried again
+// later, going by the codes the OTLP spec calls retryable.
+func (e *GRPCStatusError) retryable() bool {
+	switch e.Code {
+	case 1, 4, 8, 10, 11, 14, 15:
+		return true
+	}
+	return false
+}
+
+func (s *OTLPSink) Send(events []Event) error {
+	now := time.Now()
+	for len(events) > 0 {
+		n := len(events)
+		if n > otlpBatchSize {
+			n = otlpBatchSize
+		}
+		body := otlpRequest(events[:n], s.cfg.Region, now)
+		if s.cfg.Gzip {
+			var buf bytes.Buffer
+			gz := gzip.NewWriter(&buf)
+			gz.Write(body)
+			if err := gz.Close(); err != nil {
+				return err
+			}
+			body = buf.Bytes()
+		}
+
+		var err error
+		if s.cfg.Protocol == "grpc" {
+			err = s.exportGRPC(body)
+		} else {
+			err = s.exportHTTP(body)
+		}
+		if serr, ok := err.(*HTTPStatusError); ok && !serr.retryable() {
+			log.Printf("otlp: dropping %d events: %s", n, err)
+		} else if gerr, ok := err.(*GRPCStatusError); ok && !gerr.retryable() {
+			log.Printf("otlp: dropping %d events: %s", n, err)
+		} else if err != nil {
+			return err
+		}
+		events = events[n:]
+	}
+	return nil
+}
+
+func (s *OTLPSink) exportHTTP(body []byte) error {
+	resp, err := doWithRetry(s.cfg.Client, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", s.cfg.URL, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range s.cfg.Headers {
+			req.Header[k] = v
+		}
+		req.Header.Set("Content-Type", "application/x-protobuf")
+		if s.cfg.Gzip {
+			req.Header.Set("Content-Encoding", "gzip")
+		}
+		return req, nil
+	})
+	if err != nil {
+		return err
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	return nil
+}
+
+// exportGRPC calls LogsService/Export until it succeeds, retrying as
+// doWithRetry does for up to httpRetryDeadline.
+func (s *OTLPSink) exportGRPC(body []byte) error {
+	deadline := time.Now().Add(httpRetryDeadline)
+	for failures := 0; ; failures++ {
+		err := s.callGRPC(body)
+		if err == nil {
+			return nil
+		}
+		if serr, ok := err.(*HTTPStatusError); ok && !serr.retryable() {
+			return err
+		}
+		if gerr, ok := err.(*GRPCStatusError); ok && !gerr.retryable() {
+			return err
+		}
+		wait := retryDelay(failures, httpRetryMin, httpRetryMax)
+		if time.Now().Add(wait).After(deadline) {
+			return err
+		}
+		log.Printf("otlp: %s, retrying in %s", err, wait)
+		time.Sleep(wait)
+	}
+}
+
+// callGRPC makes one unary gRPC call with body as its message.
+func (s *OTLPSink) callGRPC(body []byte) error {
+	frame := make([]byte, 5, 5+len(body))
+	if s.cfg.Gzip {
+		frame[0] = 1
+	}
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(body)))
+	frame = append(frame, body...)
+
+	req, err := http.NewRequest("POST", s.cfg.URL, bytes.NewReader(frame))
+	if err != nil {
+		return err
+	}
+	for k, v := range s.cfg.Headers {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/grpc")
+	req.Header.Set("TE", "trailers")
+	if s.cfg.Gzip {
+		req.Header.Set("Grpc-Encoding", "gzip")
+	}
+	resp, err := s.cfg.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// The status comes in the trailers, after the response message
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return &HTTPStatusError{URL: s.cfg.URL, Status: resp.Status, StatusCode: resp.StatusCode}
+	}
+
+	status, msg := resp.Trailer.Get("Grpc-Status"), resp.Trailer.Get("Grpc-Message")
+	if status == "" {
+		// A response without a message carries its status in the headers
+		status, msg = resp.Header.Get("Grpc-Status"), resp.Header.Get("Grpc-Message")
+	}
+	if status == "0" {
+		return nil
+	}
+	code, err := strconv.Atoi(status)
+	if err != nil {
+		return fmt.Errorf("%s: bad grpc status %q", s.cfg.URL, status)
+	}
+	if m, err := url.PathUnescape(msg); err == nil {
+		msg = m
+	}
+	return &GRPCStatusError{URL: s.cfg.URL, Code: code, Message: msg}
+}
+
+func (s *OTLPSink) Close() error {
+	return nil
+}
+
+// otlpSeverities are the OTel severity numbers of syslog severities.
+var otlpSeverities = [...]uint64{
+	0: 24, // emerg: FATAL4
+	1: 23, // alert: FATAL3
+	2: 21, // crit: FATAL
+	3: 17, // err: ERROR
+	4: 13, // warning: WARN
+	5: 10, // notice: INFO2
+	6: 9,  // info: INFO
+	7: 5,  // debug: DEBUG
+}
+
+// OTLPSeverityNumber is the OTel SeverityNumber of a severity parsed from a
+// log line, e.g. 17 (ERROR) for ERROR, or 0 (UNSPECIFIED) if there was none.
+func OTLPSeverityNumber(severity string) int {
+	if severity == "" {
+		return 0
+	}
+	return int(otlpSeverities[syslogLevel(severity)])
+}
+
+// OTLPDBSystem is the db.system of an RDS engine, other_sql if it isn't
+// known.
+func OTLPDBSystem(engine string) string {
+	switch {
+	case engine == "":
+		return "other_sql"
+	case strings.Contains(engine, "postgres"):
+		return "postgresql"
+	case strings.Contains(engine, "mysql"), engine == "aurora":
+		return "mysql"
+	case strings.HasPrefix(engine, "sqlserver"):
+		return "mssql"
+	case strings.HasPrefix(engine, "oracle"):
+		return "oracle"
+	}
+	return engine
+}
+
+// otlpRequest encodes an ExportLogsServiceRequest holding events, with a
+// ResourceLogs for each instance:
+//
+//	message ExportLogsServiceRequest { repeated ResourceLogs resource_logs = 1; }
+//	message ResourceLogs { Resource resource = 1; repeated ScopeLogs scope_logs = 2; }
+//	message Resource { repeated KeyValue attributes = 1; }
+//	message ScopeLogs { InstrumentationScope scope = 1; repeated LogRecord log_records = 2; }
+//	message InstrumentationScope { string name = 1; }
+func otlpRequest(events []Event, region string, now time.Time) []byte {
+	var ids []string
+	records := make(map[string][]byte)
+	resources := make(map[string][]byte)
+	for i := range events {
+		e := &events[i]
+		if e.Raw == "" && e.Message == "" {
+			continue
+		}
+		id := e.Instance.ID
+		if _, ok := resources[id]; !ok {
+			ids = append(ids, id)
+			resources[id] = otlpResource(e.Instance, region)
+		}
+		records[id] = appendProtoBytes(records[id], 2, otlpLogRecord(e, now))
+	}
+
+	var req []byte
+	for _, id := range ids {
+		scope := appendProtoBytes(nil, 1, appendProtoBytes(nil, 1, []byte("rdstail")))
+		scope = append(scope, records[id]...)
+		var rl []byte
+		rl = appendProtoBytes(rl, 1, resources[id])
+		rl = appendProtoBytes(rl, 2, scope)
+		req = appendProtoBytes(req, 1, rl)
+	}
+	return req
+}
+
+func otlpResource(inst Instance, region string) []byte {
+	var res []byte
+	attr := func(k, v string) {
+		if v != "" {
+			res = appendProtoBytes(res, 1, otlpKeyValue(k, otlpString(v)))
+		}
+	}
+	attr("cloud.provider", "aws")
+	attr("cloud.region", region)
+	attr("db.system", OTLPDBSystem(inst.Engine))
+	attr("rds.instance.id", inst.ID)
+	attr("rds.cluster.id", inst.Cluster)
+	attr("rds.role", inst.Role)
+	return res
+}
+
+// otlpLogRecord encodes the LogRecord for e:
+//
+//	message LogRecord {
+//	  fixed64 time_unix_nano = 1;
+//	  SeverityNumber severity_number = 2;
+//	  string severity_text = 3;
+//	  AnyValue body = 5;
+//	  repeated KeyValue attributes = 6;
+//	  fixed64 observed_time_unix_nano = 11;
+//	}
+func otlpLogRecord(e *Event, now time.Time) []byte {
+	var rec []byte
+	if !e.Time.IsZero() {
+		rec = appendProtoFixed64(rec, 1, uint64(e.Time.UnixNano()))
+	}
+	if n := OTLPSeverityNumber(e.Severity); n != 0 {
+		rec = appendProtoVarint(rec, 2, uint64(n))
+		rec = appendProtoBytes(rec, 3, []byte(e.Severity))
+	}
+	body := e.Message
+	if body == "" {
+		body = e.Raw
+	}
+	rec = appendProtoBytes(rec, 5, otlpString(body))
+
+	attr := func(k string, v []byte) {
+		rec = appendProtoBytes(rec, 6, otlpKeyValue(k, v))
+	}
+	attr("log.file.name", otlpString(e.LogFile))
+	if e.User != "" {
+		attr("db.user", otlpString(e.User))
+	}
+	if e.Database != "" {
+		attr("db.name", otlpString(e.Database))
+	}
+	if e.ClientHost != "" {
+		attr("client.address", otlpString(e.ClientHost))
+	}
+	if e.PID != 0 {
+		attr("process.pid", appendProtoVarint(nil, 3, uint64(e.PID)))
+	}
+	for k, v := range e.Fields {
+		attr(k, otlpString(v))
+	}
+	return appendProtoFixed64(rec, 11, uint64(now.UnixNano()))
+}
+
+// otlpKeyValue encodes a KeyValue { string key = 1; AnyValue value = 2; }.
+func otlpKeyValue(k string, v []byte) []byte {
+	kv := appendProtoBytes(nil, 1, []byte(k))
+	return appendProtoBytes(kv, 2, v)
+}
+
+// otlpString encodes an AnyValue holding a string_value.
+func otlpString(s string) []byte {
+	return appendProtoBytes(nil, 1, []byte(s))
+}
+
+// appendProtoFixed64 appends a 64 bit field to a protobuf message.
+func appendProtoFixed64(b []byte, field int, v uint64) []byte {
+	b = appendVarint(b, uint64(field)<<3|1)
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return append(b, buf[:]...)
+}
@@ -0,0 +1,335 @@
+package rdstail_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/parser"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func TestOTLPDBSystem(t *testing.T) {
+	for engine, want := range map[string]string{
+		"postgres":          "postgresql",
+		"aurora-postgresql": "postgresql",
+		"mysql":             "mysql",
+		"aurora":            "mysql",
+		"aurora-mysql":      "mysql",
+		"mariadb":           "mariadb",
+		"sqlserver-se":      "mssql",
+		"oracle-ee":         "oracle",
+		"":                  "other_sql",
+	} {
+		if got := rdstail.OTLPDBSystem(engine); got != want {
+			t.Errorf("OTLPDBSystem(%q) = %q, want %q", engine, got, want)
+		}
+	}
+}
+
+type otlpRecord struct {
+	time, observed uint64
+	severity       uint64
+	severityText   string
+	body           string
+	// attrs hold a string for a string_value, an int64 for an int_value
+	attrs map[string]interface{}
+}
+
+type otlpResourceLogs struct {
+	attrs   map[string]interface{}
+	scope   string
+	records []otlpRecord
+}
+
+// decodeOTLPAttr decodes a KeyValue.
+func decodeOTLPAttr(t *testing.T, b []byte) (string, interface{}) {
+	t.Helper()
+	var k string
+	var v interface{}
+	for _, f := range protoFields(t, b) {
+		switch f.num {
+		case 1:
+			k = string(f.data)
+		case 2:
+			for _, vf := range protoFields(t, f.data) {
+				switch vf.num {
+				case 1:
+					v = string(vf.data)
+				case 3:
+					v = int64(vf.v)
+				default:
+					t.Fatalf("attribute %s has AnyValue field %d", k, vf.num)
+				}
+			}
+		}
+	}
+	return k, v
+}
+
+func decodeOTLPRecord(t *testing.T, b []byte) otlpRecord {
+	t.Helper()
+	rec := otlpRecord{attrs: make(map[string]interface{})}
+	for _, f := range protoFields(t, b) {
+		switch f.num {
+		case 1:
+			rec.time = f.v
+		case 2:
+			rec.severity = f.v
+		case 3:
+			rec.severityText = string(f.data)
+		case 5:
+			for _, bf := range protoFields(t, f.data) {
+				if bf.num == 1 {
+					rec.body = string(bf.data)
+				}
+			}
+		case 6:
+			k, v := decodeOTLPAttr(t, f.data)
+			rec.attrs[k] = v
+		case 11:
+			rec.observed = f.v
+		default:
+			t.Fatalf("log record has field %d", f.num)
+		}
+	}
+	return rec
+}
+
+// decodeOTLPExport decodes an ExportLogsServiceRequest.
+func decodeOTLPExport(t *testing.T, body []byte) []otlpResourceLogs {
+	t.Helper()
+	var export []otlpResourceLogs
+	for _, rf := range protoFields(t, body) {
+		if rf.num != 1 {
+			t.Fatalf("export request has field %d, want only resource_logs", rf.num)
+		}
+		rl := otlpResourceLogs{attrs: make(map[string]interface{})}
+		for _, f := range protoFields(t, rf.data) {
+			switch f.num {
+			case 1:
+				for _, af := range protoFields(t, f.data) {
+					k, v := decodeOTLPAttr(t, af.data)
+					rl.attrs[k] = v
+				}
+			case 2:
+				for _, sf := range protoFields(t, f.data) {
+					switch sf.num {
+					case 1:
+						for _, nf := range protoFields(t, sf.data) {
+							if nf.num == 1 {
+								rl.scope = string(nf.data)
+							}
+						}
+					case 2:
+						rl.records = append(rl.records, decodeOTLPRecord(t, sf.data))
+					}
+				}
+			default:
+				t.Fatalf("resource logs has field %d", f.num)
+			}
+		}
+		export = append(export, rl)
+	}
+	return export
+}
+
+func gunzip(b []byte) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(gz)
+}
+
+// otlpCollector keeps the ExportLogsServiceRequests it gets over OTLP/HTTP
+// or, without TLS, over gRPC.
+type otlpCollector struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	exports [][]byte
+}
+
+func newOTLPCollector(t *testing.T, protocol string) *otlpCollector {
+	c := &otlpCollector{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		compressed := false
+		if protocol == "http" {
+			if r.URL.Path != "/v1/logs" || r.Header.Get("Content-Type") != "application/x-protobuf" {
+				http.Error(w, "unexpected export", http.StatusNotFound)
+				return
+			}
+			compressed = r.Header.Get("Content-Encoding") == "gzip"
+		} else {
+			if r.URL.Path != "/opentelemetry.proto.collector.logs.v1.LogsService/Export" ||
+				r.Header.Get("Content-Type") != "application/grpc" || r.ProtoMajor != 2 {
+				http.Error(w, "unexpected export", http.StatusNotFound)
+				return
+			}
+			if len(body) < 5 || int(binary.BigEndian.Uint32(body[1:5])) != len(body)-5 {
+				t.Errorf("bad grpc frame of %d bytes", len(body))
+				http.Error(w, "bad frame", http.StatusBadRequest)
+				return
+			}
+			compressed = body[0] == 1
+			if compressed != (r.Header.Get("Grpc-Encoding") == "gzip") {
+				t.Errorf("grpc frame compressed is %v with grpc-encoding %q", compressed, r.Header.Get("Grpc-Encoding"))
+			}
+			body = body[5:]
+		}
+		if compressed {
+			var err error
+			if body, err = gunzip(body); err != nil {
+				t.Errorf("export isn't gzipped: %s", err)
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		c.mu.Lock()
+		c.exports = append(c.exports, body)
+		c.mu.Unlock()
+		if protocol == "http" {
+			w.Header().Set("Content-Type", "application/x-protobuf")
+			return
+		}
+		// An empty ExportLogsServiceResponse, then an OK status
+		w.Header().Set("Content-Type", "application/grpc")
+		w.Header().Set("Trailer", "Grpc-Status")
+		w.Write(make([]byte, 5))
+		w.Header().Set("Grpc-Status", "0")
+	}
+	if protocol == "http" {
+		c.Server = httptest.NewServer(http.HandlerFunc(handler))
+	} else {
+		c.Server = httptest.NewServer(h2c.NewHandler(http.HandlerFunc(handler), &http2.Server{}))
+	}
+	return c
+}
+
+func (c *otlpCollector) received() [][]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.exports
+}
+
+func checkOTLPAttrs(t *testing.T, what string, got, want map[string]interface{}) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%s has attributes %v, want %v", what, got, want)
+		return
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s has %s = %#v, want %#v", what, k, got[k], v)
+		}
+	}
+}
+
+func TestOTLPExport(t *testing.T) {
+	when := time.Date(2020, 1, 1, 10, 0, 0, 500, time.UTC)
+	prod := rdstail.Instance{ID: "prod-db", Engine: "postgres", Cluster: "prod", Role: "writer"}
+	events := []rdstail.Event{
+		{Instance: prod, LogFile: "error/postgresql.log.00", Event: parser.Event{
+			Time: when, Severity: "ERROR", User: "app", Database: "orders", ClientHost: "10.0.0.1", PID: 1234,
+			Message: "duplicate key", Raw: "2020-01-01 10:00:00 UTC:10.0.0.1(5432):app@orders:[1234]:ERROR:  duplicate key",
+			Fields: map[string]string{"sqlstate": "23505"},
+		}},
+		{Instance: rdstail.Instance{ID: "other-db"}, LogFile: "error/mysql-error.log", Event: parser.Event{Raw: "a plain line"}},
+		{Instance: prod, LogFile: "error/postgresql.log.00", Event: parser.Event{
+			Time: when.Add(time.Second), Severity: "LOG", Message: "checkpoint starting",
+		}},
+	}
+
+	for _, tc := range []struct {
+		protocol string
+		gzip     bool
+	}{
+		{"http", false},
+		{"http", true},
+		{"grpc", false},
+		{"grpc", true},
+	} {
+		srv := newOTLPCollector(t, tc.protocol)
+		sink, err := rdstail.NewOTLPSink(rdstail.OTLPConfig{Protocol: tc.protocol, URL: srv.URL, Gzip: tc.gzip, Region: "us-east-1"})
+		if err != nil {
+			t.Fatalf("NewOTLPSink: %s", err)
+		}
+		start := uint64(time.Now().UnixNano())
+		if err := sink.Send(events); err != nil {
+			t.Fatalf("%s: Send: %s", tc.protocol, err)
+		}
+		srv.Close()
+
+		exports := srv.received()
+		if len(exports) != 1 {
+			t.Fatalf("%s: got %d exports, want 1", tc.protocol, len(exports))
+		}
+		export := decodeOTLPExport(t, exports[0])
+		if len(export) != 2 {
+			t.Fatalf("%s: export has %d resources, want one for each instance", tc.protocol, len(export))
+		}
+		checkOTLPAttrs(t, tc.protocol+" prod-db resource", export[0].attrs, map[string]interface{}{
+			"cloud.provider":  "aws",
+			"cloud.region":    "us-east-1",
+			"db.system":       "postgresql",
+			"rds.instance.id": "prod-db",
+			"rds.cluster.id":  "prod",
+			"rds.role":        "writer",
+		})
+		checkOTLPAttrs(t, tc.protocol+" other-db resource", export[1].attrs, map[string]interface{}{
+			"cloud.provider":  "aws",
+			"cloud.region":    "us-east-1",
+			"db.system":       "other_sql",
+			"rds.instance.id": "other-db",
+		})
+		for _, rl := range export {
+			if rl.scope != "rdstail" {
+				t.Errorf("%s: scope is %q, want rdstail", tc.protocol, rl.scope)
+			}
+			for _, rec := range rl.records {
+				if rec.observed < start {
+					t.Errorf("%s: observed time %d is before the export", tc.protocol, rec.observed)
+				}
+			}
+		}
+		if len(export[0].records) != 2 || len(export[1].records) != 1 {
+			t.Fatalf("%s: got %d and %d records, want 2 and 1", tc.protocol, len(export[0].records), len(export[1].records))
+		}
+
+		rec := export[0].records[0]
+		if rec.time != uint64(when.UnixNano()) || rec.severity != 17 || rec.severityText != "ERROR" || rec.body != "duplicate key" {
+			t.Errorf("%s: got record %+v, want the error at %d", tc.protocol, rec, when.UnixNano())
+		}
+		checkOTLPAttrs(t, tc.protocol+" error record", rec.attrs, map[string]interface{}{
+			"log.file.name":  "error/postgresql.log.00",
+			"db.user":        "app",
+			"db.name":        "orders",
+			"client.address": "10.0.0.1",
+			"process.pid":    int64(1234),
+			"sqlstate":       "23505",
+		})
+		if rec := export[0].records[1]; rec.severity != 9 || rec.severityText != "LOG" || rec.body != "checkpoint starting" {
+			t.Errorf("%s: got record %+v, want the checkpoint", tc.protocol, rec)
+		}
+		// Without a time or severity, those fields are left out, and the raw
+		// line is the body
+		rec = export[1].records[0]
+		if rec.time != 0 || rec.severity != 0 || rec.severityText != "" || rec.body != "a plain line" {
+			t.Errorf("%s: got record %+v, want only the plain line", tc.protocol, rec)
+		}
+		checkOTLPAttrs(t, tc.protocol+" plain record", rec.attrs, map[string]interface{}{
+			"log.file.name": "error/mysql-error.log",
+		})
+	}
+}
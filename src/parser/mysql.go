@@ -0,0 +1,293 @@
+package parser
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var mysqlTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999",
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02 15:04:05",
+	"060102 15:04:05",
+}
+
+// parseMySQLTime parses the timestamps of the MySQL and MariaDB logs, from the
+// old 150313  5:32:50 style to RFC 3339.
+func parseMySQLTime(v string) time.Time {
+	v = strings.Join(strings.Fields(v), " ")
+	for _, layout := range mysqlTimeLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+const mysqlTime = `\d{4}-\d\d-\d\d[ T]\d\d:\d\d:\d\d(?:\.\d+)?(?:Z|[+-]\d\d:\d\d)?|\d{6} +\d{1,2}:\d\d:\d\d`
+
+// 2020-01-01T10:00:00.123456Z 12 [Warning] [MY-010055] [Server] message
+var mysqlErrorLine = regexp.MustCompile(`^(` + mysqlTime + `)\s+(?:(\d+)\s+)?\[(\w+)\]\s*(?:\[(MY-\d+)\]\s*)?(?:\[(\w+)\]\s*)?(.*)$`)
+
+// MySQLError parses the MySQL and MariaDB error log, error/mysql-error.log.
+// Lines without a timestamp are continuations of the entry before them.
+type MySQLError struct {
+	buf lineBuffer
+	cur *Event
+}
+
+func NewMySQLError() *MySQLError {
+	return &MySQLError{}
+}
+
+func (p *MySQLError) Parse(data string) []Event {
+	var events []Event
+	for _, line := range p.buf.lines(data) {
+		events = p.line(line, events)
+	}
+	return events
+}
+
+func (p *MySQLError) Flush() []Event {
+	var events []Event
+	if line := p.buf.flush(); line != "" {
+		events = p.line(line, events)
+	}
+	if p.cur != nil {
+		events = append(events, *p.cur)
+		p.cur = nil
+	}
+	return events
+}
+
+func (p *MySQLError) line(line string, events []Event) []Event {
+	line = strings.TrimSuffix(line, "\r")
+	m := mysqlErrorLine.FindStringSubmatch(line)
+	if m == nil {
+		if p.cur == nil {
+			p.cur = &Event{Message: line, Raw: line}
+		} else {
+			p.cur.Message += "\n" + line
+			p.cur.Raw += "\n" + line
+		}
+		return events
+	}
+
+	if p.cur != nil {
+		events = append(events, *p.cur)
+	}
+	e := Event{
+		Time:     parseMySQLTime(m[1]),
+		Severity: m[3],
+		Message:  m[6],
+		Raw:      line,
+	}
+	e.PID, _ = strconv.Atoi(m[2])
+	if m[4] != "" {
+		e.setField("error_code", m[4])
+	}
+	if m[5] != "" {
+		e.setField("subsystem", m[5])
+	}
+	p.cur = &e
+	return events
+}
+
+var (
+	// # User@Host: appuser[appuser] @  [10.0.1.5]  Id:    12
+	slowUserHost = regexp.MustCompile(`^# User@Host: ([^\[\s]*)\[[^\]]*\] @ +(\S*) *\[([^\]]*)\](?:\s+Id:\s+(\d+))?`)
+	// Query_time: 2.000123  Lock_time: 0.000050
+	slowHeaderField = regexp.MustCompile(`(\w+): +(\S+)`)
+	slowUse         = regexp.MustCompile(`(?i)^use ([^;]+);$`)
+	slowTimestamp   = regexp.MustCompile(`(?i)^SET timestamp=(\d+);$`)
+)
+
+// MySQLSlow parses the slow query log, slowquery/mysql-slowquery.log.  Each
+// event is one query: the # Time, # User@Host and # Query_time header lines
+// become fields, and the SQL that follows becomes the message.
+type MySQLSlow struct {
+	buf      lineBuffer
+	cur      *Event
+	inHeader bool
+	lastTime time.Time
+}
+
+func NewMySQLSlow() *MySQLSlow {
+	return &MySQLSlow{}
+}
+
+func (p *MySQLSlow) Parse(data string) []Event {
+	var events []Event
+	for _, line := range p.buf.lines(data) {
+		events = p.line(line, events)
+	}
+	return events
+}
+
+func (p *MySQLSlow) Flush() []Event {
+	var events []Event
+	if line := p.buf.flush(); line != "" {
+		events = p.line(line, events)
+	}
+	if p.cur != nil {
+		events = append(events, *p.cur)
+		p.cur = nil
+	}
+	return events
+}
+
+func (p *MySQLSlow) line(line string, events []Event) []Event {
+	line = strings.TrimSuffix(line, "\r")
+
+	if strings.HasPrefix(line, "# ") {
+		// A header line after the SQL starts the next query
+		if p.cur != nil && !p.inHeader {
+			events = append(events, *p.cur)
+			p.cur = nil
+		}
+		if p.cur == nil {
+			p.cur = &Event{Time: p.lastTime}
+			p.inHeader = true
+		} else {
+			p.cur.Raw += "\n"
+		}
+		p.cur.Raw += line
+		p.header(line)
+		return events
+	}
+
+	if p.cur == nil {
+		// Server start up banners and the like
+		events = append(events, Event{Message: line, Raw: line})
+		return events
+	}
+	p.inHeader = false
+	p.cur.Raw += "\n" + line
+
+	if m := slowUse.FindStringSubmatch(line); m != nil && p.cur.Message == "" {
+		p.cur.Database = m[1]
+		return events
+	}
+	if m := slowTimestamp.FindStringSubmatch(line); m != nil && p.cur.Message == "" {
+		// Only whole seconds, so keep the # Time line if it agrees
+		sec, err := strconv.ParseInt(m[1], 10, 64)
+		if err == nil && p.cur.Time.Unix() != sec {
+			p.cur.Time = time.Unix(sec, 0).UTC()
+		}
+		return events
+	}
+	if p.cur.Message == "" {
+		p.cur.Message = line
+	} else {
+		p.cur.Message += "\n" + line
+	}
+	return events
+}
+
+func (p *MySQLSlow) header(line string) {
+	e := p.cur
+	switch {
+	case strings.HasPrefix(line, "# Time: "):
+		e.Time = parseMySQLTime(strings.TrimPrefix(line, "# Time: "))
+		p.lastTime = e.Time
+	case strings.HasPrefix(line, "# User@Host: "):
+		if m := slowUserHost.FindStringSubmatch(line); m != nil {
+			e.User = m[1]
+			e.ClientHost = m[3]
+			if e.ClientHost == "" {
+				e.ClientHost = m[2]
+			}
+			e.PID, _ = strconv.Atoi(m[4])
+		}
+	default:
+		// # Query_time: 2.0  Lock_time: 0.0 Rows_sent: 1  Rows_examined: 100
+		// and MariaDB's # Thread_id: 12  Schema: mydb  QC_hit: No
+		for _, m := range slowHeaderField.FindAllStringSubmatch(line, -1) {
+			e.setField(strings.ToLower(m[1]), m[2])
+			if m[1] == "Schema" {
+				e.Database = m[2]
+			}
+		}
+	}
+}
+
+var (
+	// 2020-01-01T10:00:00.123456Z	   12 Query	SELECT 1
+	// 150313  5:32:50	   12 Query	SELECT 1
+	// 		   12 Query	SELECT 2
+	generalLine = regexp.MustCompile(`^(` + mysqlTime + `)?\s+(\d+) (\w+(?: \w+)?)(?:\t(.*))?$`)
+	// appuser@10.0.1.5 on mydb using TCP/IP
+	generalConnect = regexp.MustCompile(`^(\S+?)@(\S+) on (\S*)`)
+)
+
+// MySQLGeneral parses the general query log, general/mysql-general.log.  Each
+// event is one command, with multi-line queries kept together.
+type MySQLGeneral struct {
+	buf      lineBuffer
+	cur      *Event
+	lastTime time.Time
+}
+
+func NewMySQLGeneral() *MySQLGeneral {
+	return &MySQLGeneral{}
+}
+
+func (p *MySQLGeneral) Parse(data string) []Event {
+	var events []Event
+	for _, line := range p.buf.lines(data) {
+		events = p.line(line, events)
+	}
+	return events
+}
+
+func (p *MySQLGeneral) Flush() []Event {
+	var events []Event
+	if line := p.buf.flush(); line != "" {
+		events = p.line(line, events)
+	}
+	if p.cur != nil {
+		events = append(events, *p.cur)
+		p.cur = nil
+	}
+	return events
+}
+
+func (p *MySQLGeneral) line(line string, events []Event) []Event {
+	line = strings.TrimSuffix(line, "\r")
+	m := generalLine.FindStringSubmatch(line)
+	if m == nil {
+		if p.cur == nil {
+			p.cur = &Event{Message: line, Raw: line}
+		} else {
+			p.cur.Message += "\n" + line
+			p.cur.Raw += "\n" + line
+		}
+		return events
+	}
+
+	if p.cur != nil {
+		events = append(events, *p.cur)
+	}
+	// Entries logged within the same second as the one before leave out the
+	// time
+	if m[1] != "" {
+		p.lastTime = parseMySQLTime(m[1])
+	}
+	e := Event{
+		Time:    p.lastTime,
+		Message: m[4],
+		Raw:     line,
+	}
+	e.PID, _ = strconv.Atoi(m[2])
+	e.setField("command", m[3])
+	if m[3] == "Connect" {
+		if c := generalConnect.FindStringSubmatch(m[4]); c != nil {
+			e.User, e.ClientHost, e.Database = c[1], c[2], c[3]
+		}
+	}
+	p.cur = &e
+	return events
+}
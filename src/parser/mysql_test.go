@@ -0,0 +1,252 @@
+package parser
+
+import (
+	"testing"
+	"time"
+)
+
+// checkSplit checks that feeding log one byte at a time parses the same as
+// feeding it whole.
+func checkSplit(t *testing.T, newParser func() Parser, log string) {
+	t.Helper()
+	var chunks []string
+	for i := 0; i < len(log); i++ {
+		chunks = append(chunks, log[i:i+1])
+	}
+	checkEvents(t, parseAll(newParser(), chunks...), parseAll(newParser(), log))
+}
+
+func TestMySQLErrorLog80(t *testing.T) {
+	log := "2020-01-01T10:00:00.123456Z 0 [System] [MY-010116] [Server] /rdsdbbin/mysql/bin/mysqld (mysqld 8.0.28) starting as process 520\n" +
+		"2020-01-01T10:00:01.000000Z 12 [Warning] [MY-010055] [Server] IP address '10.0.1.5' could not be resolved: Name or service not known\n"
+	checkEvents(t, parseAll(NewMySQLError(), log), []Event{
+		{
+			Time:     time.Date(2020, 1, 1, 10, 0, 0, 123456000, time.UTC),
+			Severity: "System",
+			Message:  "/rdsdbbin/mysql/bin/mysqld (mysqld 8.0.28) starting as process 520",
+			Fields:   map[string]string{"error_code": "MY-010116", "subsystem": "Server"},
+			Raw:      "2020-01-01T10:00:00.123456Z 0 [System] [MY-010116] [Server] /rdsdbbin/mysql/bin/mysqld (mysqld 8.0.28) starting as process 520",
+		},
+		{
+			Time:     time.Date(2020, 1, 1, 10, 0, 1, 0, time.UTC),
+			PID:      12,
+			Severity: "Warning",
+			Message:  "IP address '10.0.1.5' could not be resolved: Name or service not known",
+			Fields:   map[string]string{"error_code": "MY-010055", "subsystem": "Server"},
+			Raw:      "2020-01-01T10:00:01.000000Z 12 [Warning] [MY-010055] [Server] IP address '10.0.1.5' could not be resolved: Name or service not known",
+		},
+	})
+	checkSplit(t, func() Parser { return NewMySQLError() }, log)
+}
+
+func TestMySQLErrorLog57(t *testing.T) {
+	log := "2020-01-01T10:00:00.123456Z 0 [Note] InnoDB: Buffer pool(s) load completed at 200101 10:00:00\n" +
+		"2020-01-01T10:00:01.654321Z 5 [ERROR] InnoDB: Assertion failure in thread 47 in file row0sel.cc line 3076\n" +
+		"InnoDB: We intentionally generate a memory trap.\n" +
+		"InnoDB: Submit a detailed bug report to http://bugs.mysql.com.\n"
+	checkEvents(t, parseAll(NewMySQLError(), log), []Event{
+		{
+			Time:     time.Date(2020, 1, 1, 10, 0, 0, 123456000, time.UTC),
+			Severity: "Note",
+			Message:  "InnoDB: Buffer pool(s) load completed at 200101 10:00:00",
+			Raw:      "2020-01-01T10:00:00.123456Z 0 [Note] InnoDB: Buffer pool(s) load completed at 200101 10:00:00",
+		},
+		{
+			Time:     time.Date(2020, 1, 1, 10, 0, 1, 654321000, time.UTC),
+			PID:      5,
+			Severity: "ERROR",
+			Message: "InnoDB: Assertion failure in thread 47 in file row0sel.cc line 3076\n" +
+				"InnoDB: We intentionally generate a memory trap.\n" +
+				"InnoDB: Submit a detailed bug report to http://bugs.mysql.com.",
+			Raw: "2020-01-01T10:00:01.654321Z 5 [ERROR] InnoDB: Assertion failure in thread 47 in file row0sel.cc line 3076\n" +
+				"InnoDB: We intentionally generate a memory trap.\n" +
+				"InnoDB: Submit a detailed bug report to http://bugs.mysql.com.",
+		},
+	})
+	checkSplit(t, func() Parser { return NewMySQLError() }, log)
+}
+
+func TestMariaDBErrorLog(t *testing.T) {
+	log := "150313  5:32:50 [Note] InnoDB: Started; log sequence number 1600617\n" +
+		"2020-01-01 10:00:00 12 [Warning] Aborted connection 12 to db: 'app' user: 'bob' host: '10.0.1.5' (Got timeout reading communication packets)\n"
+	checkEvents(t, parseAll(NewMySQLError(), log), []Event{
+		{
+			Time:     time.Date(2015, 3, 13, 5, 32, 50, 0, time.UTC),
+			Severity: "Note",
+			Message:  "InnoDB: Started; log sequence number 1600617",
+			Raw:      "150313  5:32:50 [Note] InnoDB: Started; log sequence number 1600617",
+		},
+		{
+			Time:     time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC),
+			PID:      12,
+			Severity: "Warning",
+			Message:  "Aborted connection 12 to db: 'app' user: 'bob' host: '10.0.1.5' (Got timeout reading communication packets)",
+			Raw:      "2020-01-01 10:00:00 12 [Warning] Aborted connection 12 to db: 'app' user: 'bob' host: '10.0.1.5' (Got timeout reading communication packets)",
+		},
+	})
+	checkSplit(t, func() Parser { return NewMySQLError() }, log)
+}
+
+const mysqlSlowLog = `/rdsdbbin/mysql/bin/mysqld, Version: 8.0.28 (Source distribution). started with:
+# Time: 2020-01-01T10:00:00.123456Z
+# User@Host: appuser[appuser] @  [10.0.1.5]  Id:    12
+# Query_time: 2.000123  Lock_time: 0.000050 Rows_sent: 1  Rows_examined: 100000
+use app;
+SET timestamp=1577872800;
+SELECT *
+FROM orders WHERE total > 100;
+# User@Host: rdsadmin[rdsadmin] @ localhost []  Id:     3
+# Query_time: 1.500000  Lock_time: 0.000000 Rows_sent: 0  Rows_examined: 0
+SET timestamp=1577872805;
+SELECT SLEEP(1.5);
+`
+
+func TestMySQLSlowLog(t *testing.T) {
+	checkEvents(t, parseAll(NewMySQLSlow(), mysqlSlowLog), []Event{
+		{
+			Message: "/rdsdbbin/mysql/bin/mysqld, Version: 8.0.28 (Source distribution). started with:",
+			Raw:     "/rdsdbbin/mysql/bin/mysqld, Version: 8.0.28 (Source distribution). started with:",
+		},
+		{
+			// SET timestamp agrees with # Time, which has the fraction
+			Time:       time.Date(2020, 1, 1, 10, 0, 0, 123456000, time.UTC),
+			ClientHost: "10.0.1.5",
+			User:       "appuser",
+			Database:   "app",
+			PID:        12,
+			Message:    "SELECT *\nFROM orders WHERE total > 100;",
+			Fields: map[string]string{
+				"query_time":    "2.000123",
+				"lock_time":     "0.000050",
+				"rows_sent":     "1",
+				"rows_examined": "100000",
+			},
+			Raw: "# Time: 2020-01-01T10:00:00.123456Z\n" +
+				"# User@Host: appuser[appuser] @  [10.0.1.5]  Id:    12\n" +
+				"# Query_time: 2.000123  Lock_time: 0.000050 Rows_sent: 1  Rows_examined: 100000\n" +
+				"use app;\nSET timestamp=1577872800;\nSELECT *\nFROM orders WHERE total > 100;",
+		},
+		{
+			// No # Time line, the time comes from SET timestamp
+			Time:       time.Date(2020, 1, 1, 10, 0, 5, 0, time.UTC),
+			ClientHost: "localhost",
+			User:       "rdsadmin",
+			PID:        3,
+			Message:    "SELECT SLEEP(1.5);",
+			Fields: map[string]string{
+				"query_time":    "1.500000",
+				"lock_time":     "0.000000",
+				"rows_sent":     "0",
+				"rows_examined": "0",
+			},
+			Raw: "# User@Host: rdsadmin[rdsadmin] @ localhost []  Id:     3\n" +
+				"# Query_time: 1.500000  Lock_time: 0.000000 Rows_sent: 0  Rows_examined: 0\n" +
+				"SET timestamp=1577872805;\nSELECT SLEEP(1.5);",
+		},
+	})
+	checkSplit(t, func() Parser { return NewMySQLSlow() }, mysqlSlowLog)
+}
+
+func TestMariaDBSlowLog(t *testing.T) {
+	log := "# Time: 200101 10:00:00\n" +
+		"# User@Host: bob[bob] @  [10.0.1.6]\n" +
+		"# Thread_id: 12  Schema: shop  QC_hit: No\n" +
+		"# Query_time: 3.100000  Lock_time: 0.000100  Rows_sent: 10  Rows_examined: 5000\n" +
+		"SET timestamp=1577872800;\n" +
+		"SELECT * FROM big;\n"
+	checkEvents(t, parseAll(NewMySQLSlow(), log), []Event{{
+		Time:       time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC),
+		ClientHost: "10.0.1.6",
+		User:       "bob",
+		Database:   "shop",
+		Message:    "SELECT * FROM big;",
+		Fields: map[string]string{
+			"thread_id":     "12",
+			"schema":        "shop",
+			"qc_hit":        "No",
+			"query_time":    "3.100000",
+			"lock_time":     "0.000100",
+			"rows_sent":     "10",
+			"rows_examined": "5000",
+		},
+		Raw: log[:len(log)-1],
+	}})
+	checkSplit(t, func() Parser { return NewMySQLSlow() }, log)
+}
+
+const mysqlGeneralLog = "2020-01-01T10:00:00.123456Z\t   12 Connect\tappuser@10.0.1.5 on app using TCP/IP\n" +
+	"2020-01-01T10:00:00.123456Z\t   12 Query\tSELECT 1\n" +
+	"2020-01-01T10:00:01.000000Z\t   12 Query\tSELECT *\n" +
+	"FROM t\n" +
+	"WHERE id = 2\n" +
+	"2020-01-01T10:00:02.000000Z\t   12 Quit\t\n"
+
+func TestMySQLGeneralLog(t *testing.T) {
+	checkEvents(t, parseAll(NewMySQLGeneral(), mysqlGeneralLog), []Event{
+		{
+			Time:       time.Date(2020, 1, 1, 10, 0, 0, 123456000, time.UTC),
+			ClientHost: "10.0.1.5",
+			User:       "appuser",
+			Database:   "app",
+			PID:        12,
+			Message:    "appuser@10.0.1.5 on app using TCP/IP",
+			Fields:     map[string]string{"command": "Connect"},
+			Raw:        "2020-01-01T10:00:00.123456Z\t   12 Connect\tappuser@10.0.1.5 on app using TCP/IP",
+		},
+		{
+			Time:    time.Date(2020, 1, 1, 10, 0, 0, 123456000, time.UTC),
+			PID:     12,
+			Message: "SELECT 1",
+			Fields:  map[string]string{"command": "Query"},
+			Raw:     "2020-01-01T10:00:00.123456Z\t   12 Query\tSELECT 1",
+		},
+		{
+			Time:    time.Date(2020, 1, 1, 10, 0, 1, 0, time.UTC),
+			PID:     12,
+			Message: "SELECT *\nFROM t\nWHERE id = 2",
+			Fields:  map[string]string{"command": "Query"},
+			Raw:     "2020-01-01T10:00:01.000000Z\t   12 Query\tSELECT *\nFROM t\nWHERE id = 2",
+		},
+		{
+			Time:   time.Date(2020, 1, 1, 10, 0, 2, 0, time.UTC),
+			PID:    12,
+			Fields: map[string]string{"command": "Quit"},
+			Raw:    "2020-01-01T10:00:02.000000Z\t   12 Quit\t",
+		},
+	})
+	checkSplit(t, func() Parser { return NewMySQLGeneral() }, mysqlGeneralLog)
+}
+
+func TestMySQLGeneralLogTimeOmitted(t *testing.T) {
+	// 5.6 and MariaDB only print the time when it changes
+	log := "150313  5:32:50\t   12 Connect\tappuser@10.0.1.5 on app\n" +
+		"\t\t   12 Query\tSELECT 2\n" +
+		"150313  5:32:51\t   13 Init DB\tshop\n"
+	checkEvents(t, parseAll(NewMySQLGeneral(), log), []Event{
+		{
+			Time:       time.Date(2015, 3, 13, 5, 32, 50, 0, time.UTC),
+			ClientHost: "10.0.1.5",
+			User:       "appuser",
+			Database:   "app",
+			PID:        12,
+			Message:    "appuser@10.0.1.5 on app",
+			Fields:     map[string]string{"command": "Connect"},
+			Raw:        "150313  5:32:50\t   12 Connect\tappuser@10.0.1.5 on app",
+		},
+		{
+			Time:    time.Date(2015, 3, 13, 5, 32, 50, 0, time.UTC),
+			PID:     12,
+			Message: "SELECT 2",
+			Fields:  map[string]string{"command": "Query"},
+			Raw:     "\t\t   12 Query\tSELECT 2",
+		},
+		{
+			Time:    time.Date(2015, 3, 13, 5, 32, 51, 0, time.UTC),
+			PID:     13,
+			Message: "shop",
+			Fields:  map[string]string{"command": "Init DB"},
+			Raw:     "150313  5:32:51\t   13 Init DB\tshop",
+		},
+	})
+	checkSplit(t, func() Parser { return NewMySQLGeneral() }, log)
+}
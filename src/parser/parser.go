@@ -0,0 +1,116 @@
+// Package parser turns raw RDS log data into structured events.
+package parser
+
+import (
+	"path"
+	"strings"
+	"time"
+)
+
+// Event is a single log entry.  Fields the log format doesn't carry are left
+// empty.
+type Event struct {
+	Time       time.Time
+	ClientHost string
+	User       string
+	Database   string
+	PID        int
+	Severity   string
+	// Message is the entry's text with any continuation and attached lines
+	// (DETAIL, HINT, the SQL of a slow query...) joined on by newlines.
+	Message string
+	// Fields holds anything else the format offers, e.g. the statement of an
+	// error or the timings of a slow query.
+	Fields map[string]string
+	// Raw is the entry exactly as it appeared in the log, without the final
+	// newline.
+	Raw string
+}
+
+func (e *Event) setField(k, v string) {
+	if e.Fields == nil {
+		e.Fields = make(map[string]string)
+	}
+	e.Fields[k] = v
+}
+
+// Parser turns raw log data into events.  Data may be fed in arbitrary chunks,
+// as it comes out of Watch: a line split across chunks, or an entry spanning
+// several lines, is held back until it is complete.
+type Parser interface {
+	// Parse consumes the next chunk of log data, returning the events it
+	// completed.
+	Parse(data string) []Event
+	// Flush returns whatever entry is still held back.
+	Flush() []Event
+}
+
+// lineBuffer splits chunks of data into whole lines, keeping a trailing
+// partial line for the next chunk.
+type lineBuffer struct {
+	partial string
+}
+
+func (b *lineBuffer) lines(data string) []string {
+	data = b.partial + data
+	i := strings.LastIndex(data, "\n")
+	if i < 0 {
+		b.partial = data
+		return nil
+	}
+	b.partial = data[i+1:]
+	return strings.Split(data[:i], "\n")
+}
+
+func (b *lineBuffer) flush() string {
+	line := b.partial
+	b.partial = ""
+	return line
+}
+
+// Line is the fallback parser, making an event of every line as is.
+type Line struct {
+	buf lineBuffer
+}
+
+func NewLine() *Line {
+	return &Line{}
+}
+
+func (p *Line) Parse(data string) []Event {
+	var events []Event
+	for _, line := range p.buf.lines(data) {
+		line = strings.TrimSuffix(line, "\r")
+		events = append(events, Event{Message: line, Raw: line})
+	}
+	return events
+}
+
+func (p *Line) Flush() []Event {
+	if line := p.buf.flush(); line != "" {
+		return []Event{{Message: line, Raw: line}}
+	}
+	return nil
+}
+
+// ForFile returns a parser suited to the RDS log file called name, e.g.
+// error/postgresql.log.2015-03-13-05 or slowquery/mysql-slowquery.log.
+// PostgreSQL logs are parsed with pgPrefix, or DefaultPostgresPrefix if it is
+// empty.
+func ForFile(name, pgPrefix string) (Parser, error) {
+	base := path.Base(name)
+	switch {
+	case strings.HasPrefix(base, "postgres"):
+		if pgPrefix == "" {
+			pgPrefix = DefaultPostgresPrefix
+		}
+		return NewPostgres(pgPrefix)
+	case strings.HasPrefix(name, "slowquery/"):
+		return NewMySQLSlow(), nil
+	case strings.HasPrefix(name, "general/"):
+		return NewMySQLGeneral(), nil
+	case strings.HasPrefix(base, "mysql-error"), strings.HasPrefix(base, "mariadb-error"):
+		return NewMySQLError(), nil
+	}
+	return NewLine(), nil
+}
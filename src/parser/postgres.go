@@ -0,0 +1,234 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultPostgresPrefix is the log_line_prefix RDS uses for PostgreSQL unless
+// the parameter group overrides it.
+const DefaultPostgresPrefix = "%t:%r:%u@%d:[%p]:"
+
+// attachedSeverities are the severities PostgreSQL uses for lines that belong
+// to the entry logged just before them by the same process.
+var attachedSeverities = map[string]bool{
+	"DETAIL":    true,
+	"HINT":      true,
+	"QUERY":     true,
+	"CONTEXT":   true,
+	"STATEMENT": true,
+	"LOCATION":  true,
+}
+
+const postgresSeverities = `DEBUG[1-5]?|INFO|NOTICE|WARNING|ERROR|LOG|FATAL|PANIC|DETAIL|HINT|QUERY|CONTEXT|STATEMENT|LOCATION`
+
+var postgresTimeLayouts = []string{
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05.000 MST",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04:05.000",
+}
+
+// Postgres parses PostgreSQL logs written with a given log_line_prefix.  Lines
+// without the prefix are continuations of the entry before them, and DETAIL,
+// HINT, STATEMENT and the like are glued onto the entry they belong to.
+type Postgres struct {
+	re      *regexp.Regexp
+	escapes []byte
+	buf     lineBuffer
+	cur     *Event
+	// field is the field of cur its continuation lines belong to, that of
+	// the attached line before them
+	field string
+}
+
+// NewPostgres returns a Postgres parser for the given log_line_prefix, e.g.
+// DefaultPostgresPrefix.
+func NewPostgres(prefix string) (*Postgres, error) {
+	var expr bytes.Buffer
+	var escapes []byte
+	optional := false
+	expr.WriteString("^")
+	for i := 0; i < len(prefix); i++ {
+		c := prefix[i]
+		if c != '%' {
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+			continue
+		}
+
+		// Skip the optional padding, e.g. %-10u
+		i++
+		for i < len(prefix) && (prefix[i] == '-' || (prefix[i] >= '0' && prefix[i] <= '9')) {
+			i++
+		}
+		if i >= len(prefix) {
+			return nil, fmt.Errorf("log_line_prefix %q ends in %%", prefix)
+		}
+
+		c = prefix[i]
+		switch c {
+		case '%':
+			expr.WriteString("%")
+			continue
+		case 'q':
+			// Non-session processes stop the prefix here
+			if !optional {
+				expr.WriteString("(?:")
+				optional = true
+			}
+			continue
+		case 't':
+			expr.WriteString(`(\d{4}-\d\d-\d\d \d\d:\d\d:\d\d(?: [A-Za-z0-9+-]+)?)`)
+		case 'm', 's':
+			expr.WriteString(`(\d{4}-\d\d-\d\d \d\d:\d\d:\d\d(?:\.\d+)?(?: [A-Za-z0-9+-]+)?)`)
+		case 'n':
+			expr.WriteString(`(\d+(?:\.\d+)?)`)
+		case 'p', 'l':
+			expr.WriteString(`\s*(\d+)\s*`)
+		case 'r':
+			// host(port), with IPv6 hosts holding colons, or [local], or nothing
+			// for background processes
+			expr.WriteString(`(\[local\]|[^()\s]*\(\d+\)|[^:\s]*)`)
+		default:
+			if !strings.ContainsRune("aubdhicevx", rune(c)) {
+				return nil, fmt.Errorf("log_line_prefix %q has unknown escape %%%c", prefix, c)
+			}
+			expr.WriteString(`(.*?)`)
+		}
+		escapes = append(escapes, c)
+	}
+	if optional {
+		expr.WriteString(")?")
+	}
+	expr.WriteString(`(` + postgresSeverities + `):\s*(.*)$`)
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil, err
+	}
+	return &Postgres{re: re, escapes: escapes}, nil
+}
+
+func (p *Postgres) Parse(data string) []Event {
+	var events []Event
+	for _, line := range p.buf.lines(data) {
+		events = p.line(line, events)
+	}
+	return events
+}
+
+func (p *Postgres) Flush() []Event {
+	var events []Event
+	if line := p.buf.flush(); line != "" {
+		events = p.line(line, events)
+	}
+	if p.cur != nil {
+		events = append(events, *p.cur)
+		p.cur = nil
+		p.field = ""
+	}
+	return events
+}
+
+func (p *Postgres) line(line string, events []Event) []Event {
+	line = strings.TrimSuffix(line, "\r")
+	m := p.re.FindStringSubmatch(line)
+	if m == nil {
+		// A continuation of a multi-line message
+		if p.cur == nil {
+			p.cur = &Event{}
+			p.cur.Message = line
+			p.cur.Raw = line
+			return events
+		}
+		p.cur.Message += "\n" + line
+		p.cur.Raw += "\n" + line
+		if p.field != "" {
+			p.cur.Fields[p.field] += "\n" + line
+		}
+		return events
+	}
+
+	e := Event{Raw: line}
+	for i, c := range p.escapes {
+		v := strings.TrimSpace(m[i+1])
+		switch c {
+		case 't', 'm':
+			e.Time = parsePostgresTime(v)
+		case 'n':
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				sec := int64(f)
+				e.Time = time.Unix(sec, int64((f-float64(sec))*1e9)).UTC()
+			}
+		case 'r':
+			host, port := splitHostPort(v)
+			e.ClientHost = host
+			if port != "" {
+				e.setField("client_port", port)
+			}
+		case 'h':
+			e.ClientHost = v
+		case 'u':
+			e.User = v
+		case 'd':
+			e.Database = v
+		case 'p':
+			e.PID, _ = strconv.Atoi(v)
+		case 'a':
+			e.setField("application", v)
+		case 'c':
+			e.setField("session", v)
+		case 'l':
+			e.setField("session_line", v)
+		case 's':
+			e.setField("session_start", v)
+		case 'i':
+			e.setField("command_tag", v)
+		case 'e':
+			e.setField("sqlstate", v)
+		case 'v':
+			e.setField("virtual_transaction", v)
+		case 'x':
+			e.setField("transaction", v)
+		}
+	}
+	severity, message := m[len(m)-2], m[len(m)-1]
+
+	if attachedSeverities[severity] && p.cur != nil && p.cur.PID == e.PID {
+		p.cur.Message += "\n" + severity + ":  " + message
+		p.cur.Raw += "\n" + line
+		p.field = strings.ToLower(severity)
+		p.cur.setField(p.field, message)
+		return events
+	}
+
+	if p.cur != nil {
+		events = append(events, *p.cur)
+	}
+	e.Severity = severity
+	e.Message = message
+	p.cur = &e
+	p.field = ""
+	return events
+}
+
+func parsePostgresTime(v string) time.Time {
+	for _, layout := range postgresTimeLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// splitHostPort splits a %r value such as 10.0.0.1(5432) into host and port.
+func splitHostPort(v string) (string, string) {
+	if i := strings.LastIndex(v, "("); i >= 0 && strings.HasSuffix(v, ")") {
+		return v[:i], v[i+1 : len(v)-1]
+	}
+	return v, ""
+}
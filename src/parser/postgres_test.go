@@ -0,0 +1,200 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// parseAll feeds chunks to p one after another and flushes it.
+func parseAll(p Parser, chunks ...string) []Event {
+	var events []Event
+	for _, c := range chunks {
+		events = append(events, p.Parse(c)...)
+	}
+	return append(events, p.Flush()...)
+}
+
+func newPostgres(t *testing.T, prefix string) *Postgres {
+	t.Helper()
+	p, err := NewPostgres(prefix)
+	if err != nil {
+		t.Fatalf("NewPostgres(%q): %s", prefix, err)
+	}
+	return p
+}
+
+func checkEvents(t *testing.T, got, want []Event) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d events, want %d: %#v", len(got), len(want), got)
+	}
+	for i := range want {
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Errorf("event %d:\n got %#v\nwant %#v", i, got[i], want[i])
+		}
+	}
+}
+
+const postgresDefaultLog = `2020-01-01 10:00:00 UTC:10.0.0.1(5432):alice@app:[1234]:ERROR:  syntax error at or near "form" at character 10
+2020-01-01 10:00:00 UTC:10.0.0.1(5432):alice@app:[1234]:STATEMENT:  select *
+	form users
+	where id = 1
+2020-01-01 10:00:01 UTC:10.0.0.2(6000):bob@app:[1300]:LOG:  duration: 1.5 ms
+2020-01-01 10:00:02 UTC:10.0.0.1(5432):alice@app:[1234]:WARNING:  there is no transaction in progress
+2020-01-01 10:00:02 UTC:10.0.0.1(5432):alice@app:[1234]:DETAIL:  first detail line
+	second detail line
+2020-01-01 10:00:02 UTC:10.0.0.1(5432):alice@app:[1234]:HINT:  a hint
+`
+
+func postgresDefaultEvents() []Event {
+	return []Event{
+		{
+			Time:       time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC),
+			ClientHost: "10.0.0.1",
+			User:       "alice",
+			Database:   "app",
+			PID:        1234,
+			Severity:   "ERROR",
+			Message:    "syntax error at or near \"form\" at character 10\nSTATEMENT:  select *\n\tform users\n\twhere id = 1",
+			Fields: map[string]string{
+				"client_port": "5432",
+				"statement":   "select *\n\tform users\n\twhere id = 1",
+			},
+			Raw: "2020-01-01 10:00:00 UTC:10.0.0.1(5432):alice@app:[1234]:ERROR:  syntax error at or near \"form\" at character 10\n" +
+				"2020-01-01 10:00:00 UTC:10.0.0.1(5432):alice@app:[1234]:STATEMENT:  select *\n\tform users\n\twhere id = 1",
+		},
+		{
+			Time:       time.Date(2020, 1, 1, 10, 0, 1, 0, time.UTC),
+			ClientHost: "10.0.0.2",
+			User:       "bob",
+			Database:   "app",
+			PID:        1300,
+			Severity:   "LOG",
+			Message:    "duration: 1.5 ms",
+			Fields:     map[string]string{"client_port": "6000"},
+			Raw:        "2020-01-01 10:00:01 UTC:10.0.0.2(6000):bob@app:[1300]:LOG:  duration: 1.5 ms",
+		},
+		{
+			Time:       time.Date(2020, 1, 1, 10, 0, 2, 0, time.UTC),
+			ClientHost: "10.0.0.1",
+			User:       "alice",
+			Database:   "app",
+			PID:        1234,
+			Severity:   "WARNING",
+			Message:    "there is no transaction in progress\nDETAIL:  first detail line\n\tsecond detail line\nHINT:  a hint",
+			Fields: map[string]string{
+				"client_port": "5432",
+				"detail":      "first detail line\n\tsecond detail line",
+				"hint":        "a hint",
+			},
+			Raw: "2020-01-01 10:00:02 UTC:10.0.0.1(5432):alice@app:[1234]:WARNING:  there is no transaction in progress\n" +
+				"2020-01-01 10:00:02 UTC:10.0.0.1(5432):alice@app:[1234]:DETAIL:  first detail line\n\tsecond detail line\n" +
+				"2020-01-01 10:00:02 UTC:10.0.0.1(5432):alice@app:[1234]:HINT:  a hint",
+		},
+	}
+}
+
+func TestPostgresDefaultPrefix(t *testing.T) {
+	p := newPostgres(t, DefaultPostgresPrefix)
+	checkEvents(t, parseAll(p, postgresDefaultLog), postgresDefaultEvents())
+}
+
+func TestPostgresSplitChunks(t *testing.T) {
+	// Every way of cutting the log in two, and byte by byte
+	for i := 0; i <= len(postgresDefaultLog); i++ {
+		p := newPostgres(t, DefaultPostgresPrefix)
+		checkEvents(t, parseAll(p, postgresDefaultLog[:i], postgresDefaultLog[i:]), postgresDefaultEvents())
+	}
+	var chunks []string
+	for i := 0; i < len(postgresDefaultLog); i++ {
+		chunks = append(chunks, postgresDefaultLog[i:i+1])
+	}
+	checkEvents(t, parseAll(newPostgres(t, DefaultPostgresPrefix), chunks...), postgresDefaultEvents())
+}
+
+func TestPostgresHeldUntilComplete(t *testing.T) {
+	p := newPostgres(t, DefaultPostgresPrefix)
+	if events := p.Parse("2020-01-01 10:00:00 UTC:10.0.0.1(5432):alice@app:[1234]:ERROR:  oops\n"); len(events) != 0 {
+		t.Fatalf("entry that may go on returned early: %#v", events)
+	}
+	events := p.Parse("2020-01-01 10:00:00 UTC:10.0.0.1(5432):alice@app:[1234]:STATEMENT:  select 1\n" +
+		"2020-01-01 10:00:01 UTC:10.0.0.1(5432):alice@app:[1234]:LOG:  next\n")
+	if len(events) != 1 || events[0].Fields["statement"] != "select 1" {
+		t.Fatalf("got %#v, want the error with its statement", events)
+	}
+	if events := p.Flush(); len(events) != 1 || events[0].Message != "next" {
+		t.Fatalf("flush got %#v, want the last entry", events)
+	}
+}
+
+func TestPostgresLocalAndBackground(t *testing.T) {
+	p := newPostgres(t, DefaultPostgresPrefix)
+	events := parseAll(p, "2020-01-01 10:00:00 UTC:[local]:postgres@postgres:[99]:LOG:  connection authorized\n"+
+		"2020-01-01 10:00:01 UTC::@:[77]:LOG:  checkpoint starting: time\n")
+	checkEvents(t, events, []Event{
+		{
+			Time:       time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC),
+			ClientHost: "[local]",
+			User:       "postgres",
+			Database:   "postgres",
+			PID:        99,
+			Severity:   "LOG",
+			Message:    "connection authorized",
+			Raw:        "2020-01-01 10:00:00 UTC:[local]:postgres@postgres:[99]:LOG:  connection authorized",
+		},
+		{
+			Time:     time.Date(2020, 1, 1, 10, 0, 1, 0, time.UTC),
+			PID:      77,
+			Severity: "LOG",
+			Message:  "checkpoint starting: time",
+			Raw:      "2020-01-01 10:00:01 UTC::@:[77]:LOG:  checkpoint starting: time",
+		},
+	})
+}
+
+func TestPostgresCustomPrefix(t *testing.T) {
+	// %m with milliseconds, and %q ending the prefix for non-session processes
+	p := newPostgres(t, "%m [%p] %q%u@%d ")
+	events := parseAll(p, "2020-01-01 10:00:00.123 UTC [42] LOG:  checkpoint complete\n"+
+		"2020-01-01 10:00:01.500 UTC [43] alice@app ERROR:  relation \"nope\" does not exist\n")
+	checkEvents(t, events, []Event{
+		{
+			Time:     time.Date(2020, 1, 1, 10, 0, 0, 123000000, time.UTC),
+			PID:      42,
+			Severity: "LOG",
+			Message:  "checkpoint complete",
+			Raw:      "2020-01-01 10:00:00.123 UTC [42] LOG:  checkpoint complete",
+		},
+		{
+			Time:     time.Date(2020, 1, 1, 10, 0, 1, 500000000, time.UTC),
+			User:     "alice",
+			Database: "app",
+			PID:      43,
+			Severity: "ERROR",
+			Message:  "relation \"nope\" does not exist",
+			Raw:      "2020-01-01 10:00:01.500 UTC [43] alice@app ERROR:  relation \"nope\" does not exist",
+		},
+	})
+
+	// %h for the host alone, with %a and %e
+	p = newPostgres(t, "%t:%h:%a:%e:[%p]:")
+	events = parseAll(p, "2020-01-01 10:00:00 UTC:10.1.2.3:psql:42P01:[7]:ERROR:  missing\n")
+	checkEvents(t, events, []Event{{
+		Time:       time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC),
+		ClientHost: "10.1.2.3",
+		PID:        7,
+		Severity:   "ERROR",
+		Message:    "missing",
+		Fields:     map[string]string{"application": "psql", "sqlstate": "42P01"},
+		Raw:        "2020-01-01 10:00:00 UTC:10.1.2.3:psql:42P01:[7]:ERROR:  missing",
+	}})
+}
+
+func TestPostgresBadPrefix(t *testing.T) {
+	for _, prefix := range []string{"%t:%", "%t:%z:"} {
+		if _, err := NewPostgres(prefix); err == nil {
+			t.Errorf("NewPostgres(%q) succeeded, want an error", prefix)
+		}
+	}
+}
@@ -2,40 +2,49 @@ package rdstail
 
 import (
 	"bytes"
-	"crypto/tls"
-	"crypto/x509"
 	"errors"
 	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/chrismrivera/backoff"
 )
 
-const (
-	papertrailBackoffMaxWait  = time.Minute
-	papertrailBackoffDeadline = time.Minute * 5
-	// aws-sdk-go already offers retry functionality
-)
+// LogTypes maps the MySQL log types to a pattern matching their log files.
+var LogTypes = map[string]string{
+	"error":   "error/*",
+	"slow":    "slowquery/*",
+	"general": "general/*",
+}
+
+// LogSource is the subset of the RDS API used to read log files.  *rds.RDS
+// satisfies it; rdstailtest.LogSource is an in-memory fake.
+type LogSource interface {
+	DescribeDBLogFilesPages(*rds.DescribeDBLogFilesInput, func(*rds.DescribeDBLogFilesOutput, bool) bool) error
+	DownloadDBLogFilePortionPages(*rds.DownloadDBLogFilePortionInput, func(*rds.DownloadDBLogFilePortionOutput, bool) bool) error
+}
 
-func getMostRecentLogFile(r *rds.RDS, db string) (file *rds.DescribeDBLogFilesDetails, err error) {
+func getMostRecentLogFile(r LogSource, db, pattern string) (file *rds.DescribeDBLogFilesDetails, err error) {
 	yesterday := time.Now().Add(-24 * time.Hour).Unix()
-	file, err = getMostRecentLogFileSince(r, db, yesterday)
+	file, err = getMostRecentLogFileSince(r, db, pattern, yesterday)
 	if err != nil {
 		return
 	}
 
 	if file == nil {
 		lastWeek := time.Now().Add(-7 * 24 * time.Hour).Unix()
-		file, err = getMostRecentLogFileSince(r, db, lastWeek)
+		file, err = getMostRecentLogFileSince(r, db, pattern, lastWeek)
 		if err != nil {
 			return
 		}
 	}
 
 	if file == nil {
-		file, err = getMostRecentLogFileSince(r, db, 0)
+		file, err = getMostRecentLogFileSince(r, db, pattern, 0)
 		if err != nil {
 			return
 		}
@@ -44,8 +53,8 @@ func getMostRecentLogFile(r *rds.RDS, db string) (file *rds.DescribeDBLogFilesDe
 	return
 }
 
-func getMostRecentLogFileSince(r *rds.RDS, db string, since int64) (file *rds.DescribeDBLogFilesDetails, err error) {
-	resp, err := describeLogFiles(r, db, since)
+func getMostRecentLogFileSince(r LogSource, db, pattern string, since int64) (file *rds.DescribeDBLogFilesDetails, err error) {
+	resp, err := describeLogFiles(r, db, pattern, since)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +68,18 @@ func getMostRecentLogFileSince(r *rds.RDS, db string, since int64) (file *rds.De
 	return
 }
 
-func describeLogFiles(r *rds.RDS, db string, since int64) (details []*rds.DescribeDBLogFilesDetails, err error) {
+// describeLogFiles lists the log files of db written since the given epoch
+// milliseconds.  If pattern isn't empty only files whose name matches it, as a
+// glob or /regex/, are returned.
+func describeLogFiles(r LogSource, db, pattern string, since int64) (details []*rds.DescribeDBLogFilesDetails, err error) {
+	match := func(string) bool { return true }
+	if pattern != "" {
+		match, err = compilePattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	req := &rds.DescribeDBLogFilesInput{
 		DBInstanceIdentifier: aws.String(db),
 	}
@@ -68,14 +88,21 @@ func describeLogFiles(r *rds.RDS, db string, since int64) (details []*rds.Descri
 	}
 
 	err = r.DescribeDBLogFilesPages(req, func(p *rds.DescribeDBLogFilesOutput, lastPage bool) bool {
-		details = append(details, p.DescribeDBLogFiles...)
+		for _, d := range p.DescribeDBLogFiles {
+			if d.LogFileName != nil && match(*d.LogFileName) {
+				details = append(details, d)
+			}
+		}
 		return true
 	})
 
 	return
 }
 
-func tailLogFile(r *rds.RDS, db, name string, numLines int64, marker string) (string, string, error) {
+// tailLogFile reads name from marker to its end, or just its last numLines
+// lines.  If RDS truncates any of it, a *TruncatedError is returned along
+// with the lines and marker.
+func tailLogFile(r LogSource, db, name string, numLines int64, marker string) (string, string, error) {
 	req := &rds.DownloadDBLogFilePortionInput{
 		DBInstanceIdentifier: aws.String(db),
 		LogFileName:          aws.String(name),
@@ -89,9 +116,15 @@ func tailLogFile(r *rds.RDS, db, name string, numLines int64, marker string) (st
 
 	var buf bytes.Buffer
 	var markerPtr *string
+	truncated := false
+	var upToTruncation string
 	err := r.DownloadDBLogFilePortionPages(req, func(p *rds.DownloadDBLogFilePortionOutput, lastPage bool) bool {
 		if p.LogFileData != nil {
 			buf.WriteString(*p.LogFileData)
+			if !truncated && endsTruncated(*p.LogFileData) {
+				truncated = true
+				upToTruncation = buf.String()
+			}
 		}
 		if lastPage {
 			markerPtr = p.Marker
@@ -104,13 +137,124 @@ func tailLogFile(r *rds.RDS, db, name string, numLines int64, marker string) (st
 		marker = *markerPtr
 	}
 
+	if err == nil && truncated {
+		err = &TruncatedError{DB: db, LogFile: name, Data: upToTruncation}
+	}
 	return buf.String(), marker, err
 }
 
+// lastLines returns the last n lines of s.
+func lastLines(s string, n int) string {
+	s = strings.TrimSuffix(s, "\n")
+	i := len(s)
+	for ; n > 0 && i > 0; n-- {
+		i = strings.LastIndex(s[:i], "\n")
+		if i < 0 {
+			return s
+		}
+	}
+	if i == len(s) {
+		return ""
+	}
+	return s[i+1:]
+}
+
+// resume catches up on everything written since cp was saved: the rest of the
+// checkpointed file, if RDS still has it, then every file written after it.
+// It returns the file to keep watching and the position to continue from, or
+// a nil file if there is nothing to resume from.
+func resume(r LogSource, db, pattern string, cp *Checkpoint, emit func(*rds.DescribeDBLogFilesDetails, string, string) error) (*rds.DescribeDBLogFilesDetails, position, error) {
+	current, pending, err := listLogFilesSince(r, db, pattern, cp.LogFileName, cp.LastWritten)
+	if err != nil {
+		return nil, position{}, err
+	}
+
+	// How far into the file the marker is isn't saved, so it counts as 0
+	pos := position{marker: cp.Marker}
+	if current != nil {
+		pending = append([]*rds.DescribeDBLogFilesDetails{current}, pending...)
+	} else {
+		// The checkpointed file rotated away, start the next one from the top
+		pos.marker = startMarker
+	}
+	if len(pending) == 0 {
+		return nil, position{}, nil
+	}
+
+	pos, err = drainLogFiles(r, db, pending, pos, emit)
+	if err != nil {
+		return nil, position{}, err
+	}
+	return pending[len(pending)-1], pos, nil
+}
+
+// listLogFilesSince looks up the log file called name along with every other
+// file written after lastWritten, oldest first.  current is nil if name no
+// longer exists.
+func listLogFilesSince(r LogSource, db, pattern, name string, lastWritten int64) (current *rds.DescribeDBLogFilesDetails, newer []*rds.DescribeDBLogFilesDetails, err error) {
+	files, err := describeLogFiles(r, db, pattern, lastWritten)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, d := range files {
+		if d.LogFileName == nil || d.LastWritten == nil {
+			continue
+		}
+		if *d.LogFileName == name {
+			current = d
+		} else if *d.LastWritten > lastWritten {
+			newer = append(newer, d)
+		}
+	}
+	sort.Sort(byLastWritten(newer))
+	return
+}
+
+// startMarker reads a log file from its start.  Without a marker RDS only
+// returns its last 10000 lines.
+const startMarker = "0"
+
+// position is how far a log file has been read: the marker to read on from,
+// and at least how many bytes of the file come before it.  RDS markers don't
+// tell, but finding a truncated read in a complete download needs to know.
+type position struct {
+	marker string
+	offset int64
+}
+
+// drainLogFiles reads files[0] from pos to its end, then each following file
+// in full, passing everything read to emit.  It returns the position at the
+// end of the last file.
+func drainLogFiles(r LogSource, db string, files []*rds.DescribeDBLogFilesDetails, pos position, emit func(*rds.DescribeDBLogFilesDetails, string, string) error) (position, error) {
+	for i, file := range files {
+		if i > 0 {
+			pos = position{marker: startMarker}
+		}
+		lines, newPos, err := readLogFile(r, db, *file.LogFileName, pos)
+		if err != nil {
+			return position{}, err
+		}
+		pos = newPos
+		if lines != "" {
+			if err := emit(file, lines, pos.marker); err != nil {
+				return position{}, err
+			}
+		}
+	}
+	return pos, nil
+}
+
+type byLastWritten []*rds.DescribeDBLogFilesDetails
+
+func (b byLastWritten) Len() int           { return len(b) }
+func (b byLastWritten) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byLastWritten) Less(i, j int) bool { return *b[i].LastWritten < *b[j].LastWritten }
+
 /// cmds
 
-func Tail(r *rds.RDS, db string, numLines int64) error {
-	logFile, err := getMostRecentLogFile(r, db)
+func Tail(r LogSource, db, pattern string, numLines int64) error {
+	logFile, err := getMostRecentLogFile(r, db, pattern)
 	if err != nil {
 		return nil
 	}
@@ -119,31 +263,106 @@ func Tail(r *rds.RDS, db string, numLines int64) error {
 	}
 
 	tail, _, err := tailLogFile(r, db, *logFile.LogFileName, numLines, "")
-	if err != nil {
+	if terr, ok := err.(*TruncatedError); ok {
+		log.Printf("%s, falling back to a complete download", terr)
+		if numLines == 0 {
+			_, err = copyCompleteLogFile(r, db, *logFile.LogFileName, os.Stdout)
+			return err
+		}
+		var buf bytes.Buffer
+		if _, err := copyCompleteLogFile(r, db, *logFile.LogFileName, &buf); err != nil {
+			return err
+		}
+		tail = lastLines(buf.String(), int(numLines))
+	} else if err != nil {
 		return err
 	}
 	fmt.Println(tail)
 	return nil
 }
 
-func Watch(r *rds.RDS, db string, rate time.Duration, callback func(string) error, stop <-chan struct{}) error {
+// Watch follows the log files of db whose names match pattern, or all of them
+// if it is empty, passing new lines to callback along with the name of the
+// file they came from.  Progress is saved to store, if given, under a key
+// made of db and pattern.
+// Once a file goes quiet, or is left for the next one, callback is called for
+// it without lines, so anything held back waiting for more can be let go of.
+func Watch(r LogSource, db, pattern string, rate time.Duration, store CheckpointStore, callback func(logFile, lines string) error, stop <-chan struct{}) error {
 	// Periodically check for new log files (unless there is a way to detect the file is done being written to)
 	// Poll that log file, retaining the marker
-	logFile, err := getMostRecentLogFile(r, db)
-	if err != nil {
-		return err
+	var logFile *rds.DescribeDBLogFilesDetails
+	var pos position
+
+	// last is the file lines were last passed on from, until it goes quiet
+	var last string
+	quiet := func() error {
+		if last == "" {
+			return nil
+		}
+		name := last
+		last = ""
+		return callback(name, "")
 	}
-	if logFile == nil {
-		return errors.New("no log files")
+
+	// emit hands lines to the callback and, once it succeeds, records how far we got
+	emit := func(file *rds.DescribeDBLogFilesDetails, lines, marker string) error {
+		if last != *file.LogFileName {
+			if err := quiet(); err != nil {
+				return err
+			}
+		}
+		if err := callback(*file.LogFileName, lines); err != nil {
+			return err
+		}
+		last = *file.LogFileName
+		if store == nil {
+			return nil
+		}
+		return store.Save(checkpointKey(db, pattern), Checkpoint{
+			LogFileName: *file.LogFileName,
+			LastWritten: *file.LastWritten,
+			Marker:      marker,
+		})
 	}
 
-	// Get a marker for the end of the log file by requesting the most recent line
-	lines, marker, err := tailLogFile(r, db, *logFile.LogFileName, 1, "")
-	if err != nil {
-		return err
+	var cp *Checkpoint
+	if store != nil {
+		var err error
+		cp, err = store.Load(checkpointKey(db, pattern))
+		if err != nil {
+			return err
+		}
+	}
+
+	if cp != nil {
+		var err error
+		logFile, pos, err = resume(r, db, pattern, cp, emit)
+		if err != nil {
+			return err
+		}
+	}
+
+	if logFile == nil {
+		var err error
+		logFile, err = getMostRecentLogFile(r, db, pattern)
+		if err != nil {
+			return err
+		}
+		if logFile == nil {
+			return errors.New("no log files")
+		}
+
+		// Get a marker for the end of the log file by requesting the most
+		// recent line.  The file was at least as long as listed by then.
+		pos.offset = aws.Int64Value(logFile.Size)
+		_, pos.marker, err = tailLogFile(r, db, *logFile.LogFileName, 1, "")
+		if _, truncated := err.(*TruncatedError); err != nil && !truncated {
+			return err
+		}
 	}
 
 	t := time.NewTicker(rate)
+	defer t.Stop()
 	empty := 0
 	const checkLogfileRate = 4
 	for {
@@ -152,26 +371,39 @@ func Watch(r *rds.RDS, db string, rate time.Duration, callback func(string) erro
 			// If the logfile tail was empty n times, check for a newer log file
 			if empty >= checkLogfileRate {
 				empty = 0
-				newLogFile, err := getMostRecentLogFileSince(r, db, *logFile.LastWritten)
+				current, newer, err := listLogFilesSince(r, db, pattern, *logFile.LogFileName, *logFile.LastWritten)
 				if err != nil {
 					return err
 				}
-				if newLogFile != nil {
-					logFile = newLogFile
-					marker = ""
+				if current != nil {
+					logFile = current
+				}
+				if len(newer) > 0 {
+					// Finish the old file, then read every file that rotated in
+					// since, in order, so nothing written in between is skipped
+					files := append([]*rds.DescribeDBLogFilesDetails{logFile}, newer...)
+					pos, err = drainLogFiles(r, db, files, pos, emit)
+					if err != nil {
+						return err
+					}
+					logFile = newer[len(newer)-1]
 				}
 			}
 
-			lines, marker, err = tailLogFile(r, db, *logFile.LogFileName, 0, marker)
+			lines, newPos, err := readLogFile(r, db, *logFile.LogFileName, pos)
 			if err != nil {
 				return err
 			}
+			pos = newPos
 
 			if lines == "" {
 				empty++
+				if err := quiet(); err != nil {
+					return err
+				}
 			} else {
 				empty = 0
-				if err := callback(lines); err != nil {
+				if err := emit(logFile, lines, pos.marker); err != nil {
 					return err
 				}
 			}
@@ -179,39 +411,4 @@ func Watch(r *rds.RDS, db string, rate time.Duration, callback func(string) erro
 			return nil
 		}
 	}
-
-	return nil
-}
-
-func FeedPapertrail(r *rds.RDS, db string, rate time.Duration, papertrailHost, app, hostname string, stop <-chan struct{}) error {
-	nameSegment := fmt.Sprintf(" %s %s: ", hostname, app)
-
-	// Establish TLS connection with papertrail
-	roots := x509.NewCertPool()
-	ok := roots.AppendCertsFromPEM([]byte(papertrailPEM))
-	if !ok {
-		return errors.New("failed to parse papertrail root certificate")
-	}
-
-	conn, err := tls.Dial("tcp", papertrailHost, &tls.Config{
-		RootCAs: roots,
-	})
-	if err != nil {
-		return err
-	}
-	defer conn.Close()
-
-	// watch with callback writing to the connection
-	buf := bytes.Buffer{}
-	return Watch(r, db, rate, func(lines string) error {
-		timestamp := time.Now().UTC().Format("2006-01-02T15:04:05")
-		buf.Reset()
-		buf.WriteString(timestamp)
-		buf.WriteString(nameSegment)
-		buf.WriteString(lines)
-		return backoff.Try(papertrailBackoffMaxWait, papertrailBackoffDeadline, func() error {
-			_, err := conn.Write(buf.Bytes())
-			return err
-		})
-	}, stop)
 }
@@ -0,0 +1,456 @@
+package rdstail_test
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/rdstailtest"
+)
+
+const (
+	testDB   = "db"
+	testRate = 2 * time.Millisecond
+)
+
+// testSource counts the log file portions read, so a test knows Watch has
+// started, and the complete downloads, and lets a test make several changes
+// that Watch sees all at once.
+type testSource struct {
+	*rdstailtest.LogSource
+	mu        sync.RWMutex
+	downloads int32
+	completes int32
+}
+
+func newTestSource() *testSource {
+	return &testSource{LogSource: rdstailtest.NewLogSource()}
+}
+
+func (s *testSource) DescribeDBLogFilesPages(req *rds.DescribeDBLogFilesInput, fn func(*rds.DescribeDBLogFilesOutput, bool) bool) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.LogSource.DescribeDBLogFilesPages(req, fn)
+}
+
+func (s *testSource) DownloadDBLogFilePortionPages(req *rds.DownloadDBLogFilePortionInput, fn func(*rds.DownloadDBLogFilePortionOutput, bool) bool) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	defer atomic.AddInt32(&s.downloads, 1)
+	return s.LogSource.DownloadDBLogFilePortionPages(req, fn)
+}
+
+func (s *testSource) DownloadCompleteLogFile(db, name string) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	atomic.AddInt32(&s.completes, 1)
+	return s.LogSource.DownloadCompleteLogFile(db, name)
+}
+
+// batch runs f without Watch reading anything in the meantime.
+func (s *testSource) batch(f func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f()
+}
+
+type memoryStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]rdstail.Checkpoint
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{checkpoints: make(map[string]rdstail.Checkpoint)}
+}
+
+func (s *memoryStore) Load(key string) (*rdstail.Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cp, ok := s.checkpoints[key]
+	if !ok {
+		return nil, nil
+	}
+	return &cp, nil
+}
+
+func (s *memoryStore) Save(key string, cp rdstail.Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[key] = cp
+	return nil
+}
+
+func (s *memoryStore) get(key string) rdstail.Checkpoint {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoints[key]
+}
+
+// chunk is what one call to the Watch callback got.
+type chunk struct {
+	file  string
+	lines string
+}
+
+type watchRun struct {
+	src   *testSource
+	store rdstail.CheckpointStore
+	stop  chan struct{}
+	done  chan error
+
+	mu     sync.Mutex
+	chunks []chunk
+}
+
+func startWatch(t *testing.T, src *testSource, store rdstail.CheckpointStore) *watchRun {
+	return startPatternWatch(t, src, "", store)
+}
+
+// startPatternWatch watches only the files of testDB matching pattern.
+func startPatternWatch(t *testing.T, src *testSource, pattern string, store rdstail.CheckpointStore) *watchRun {
+	w := &watchRun{src: src, store: store, stop: make(chan struct{}), done: make(chan error, 1)}
+	go func() {
+		w.done <- rdstail.Watch(src, testDB, pattern, testRate, store, func(logFile, lines string) error {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+			w.chunks = append(w.chunks, chunk{logFile, lines})
+			return nil
+		}, w.stop)
+	}()
+	return w
+}
+
+// waitStarted waits for Watch to have found where to start reading from.
+func (w *watchRun) waitStarted(t *testing.T) {
+	waitUntil(t, "watch to start", func() bool { return atomic.LoadInt32(&w.src.downloads) > 0 })
+}
+
+func (w *watchRun) output() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var out []string
+	for _, c := range w.chunks {
+		out = append(out, c.lines)
+	}
+	return strings.Join(out, "")
+}
+
+// files returns the files lines were read from, in order, without repeats.
+func (w *watchRun) files() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var files []string
+	for _, c := range w.chunks {
+		if len(files) == 0 || files[len(files)-1] != c.file {
+			files = append(files, c.file)
+		}
+	}
+	return files
+}
+
+func (w *watchRun) waitOutput(t *testing.T, want string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if got := w.output(); got == want {
+			return
+		} else if !strings.HasPrefix(want, got) {
+			t.Fatalf("watch output %q, want %q", got, want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("watch output %q, want %q", w.output(), want)
+}
+
+func (w *watchRun) finish(t *testing.T) {
+	t.Helper()
+	close(w.stop)
+	if err := <-w.done; err != nil {
+		t.Fatalf("Watch: %s", err)
+	}
+}
+
+func waitUntil(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// checkMarker waits for the saved checkpoint to be at the end of name, the
+// fake's markers being byte offsets.
+func checkMarker(t *testing.T, src *testSource, store *memoryStore, name string, size int) {
+	t.Helper()
+	marker := strconv.Itoa(size)
+	deadline := time.Now().Add(5 * time.Second)
+	cp := store.get(testDB)
+	for (cp.LogFileName != name || cp.Marker != marker) && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+		cp = store.get(testDB)
+	}
+	if cp.LogFileName != name || cp.Marker != marker {
+		t.Fatalf("checkpoint at %s %q, want %s %q", cp.LogFileName, cp.Marker, name, marker)
+	}
+	// LastWritten is as of the last listing, which may be behind the file
+	if cp.LastWritten <= 0 || cp.LastWritten > src.LastWritten(testDB, name) {
+		t.Errorf("checkpoint last written %d, file last written %d", cp.LastWritten, src.LastWritten(testDB, name))
+	}
+}
+
+func TestWatchStartsAtEnd(t *testing.T) {
+	src := newTestSource()
+	old := "old 1\nold 2\nold 3\n"
+	src.Append(testDB, "error/postgresql.log.00", old)
+	store := newMemoryStore()
+
+	w := startWatch(t, src, store)
+	w.waitStarted(t)
+	src.Append(testDB, "error/postgresql.log.00", "new 1\n")
+	w.waitOutput(t, "new 1\n")
+	w.finish(t)
+
+	checkMarker(t, src, store, "error/postgresql.log.00", len(old)+len("new 1\n"))
+}
+
+func TestWatchGrowth(t *testing.T) {
+	src := newTestSource()
+	src.Append(testDB, "error/postgresql.log.00", "start\n")
+	store := newMemoryStore()
+
+	w := startWatch(t, src, store)
+	w.waitStarted(t)
+	want := ""
+	size := len("start\n")
+	for i := 0; i < 5; i++ {
+		lines := "line " + strconv.Itoa(i) + "\nmore " + strconv.Itoa(i) + "\n"
+		src.Append(testDB, "error/postgresql.log.00", lines)
+		want += lines
+		size += len(lines)
+		w.waitOutput(t, want)
+		checkMarker(t, src, store, "error/postgresql.log.00", size)
+	}
+	w.finish(t)
+}
+
+func TestWatchPagination(t *testing.T) {
+	src := newTestSource()
+	src.PageSize = 16
+	src.Append(testDB, "error/postgresql.log.00", "start\n")
+	store := newMemoryStore()
+
+	w := startWatch(t, src, store)
+	w.waitStarted(t)
+	var lines string
+	for i := 0; i < 40; i++ {
+		lines += "paged line " + strconv.Itoa(i) + "\n"
+	}
+	src.Append(testDB, "error/postgresql.log.00", lines)
+	w.waitOutput(t, lines)
+	w.finish(t)
+
+	checkMarker(t, src, store, "error/postgresql.log.00", len("start\n")+len(lines))
+}
+
+func TestWatchResumesFromMarker(t *testing.T) {
+	src := newTestSource()
+	seen := "seen 1\nseen 2\n"
+	src.Append(testDB, "error/postgresql.log.00", seen+"missed 1\nmissed 2\n")
+	store := newMemoryStore()
+	store.Save(testDB, rdstail.Checkpoint{
+		LogFileName: "error/postgresql.log.00",
+		LastWritten: src.LastWritten(testDB, "error/postgresql.log.00") - 1,
+		Marker:      strconv.Itoa(len(seen)),
+	})
+
+	w := startWatch(t, src, store)
+	w.waitOutput(t, "missed 1\nmissed 2\n")
+	src.Append(testDB, "error/postgresql.log.00", "new 1\n")
+	w.waitOutput(t, "missed 1\nmissed 2\nnew 1\n")
+	w.finish(t)
+
+	checkMarker(t, src, store, "error/postgresql.log.00", len(seen)+len("missed 1\nmissed 2\nnew 1\n"))
+}
+
+func TestWatchResumesAfterRotation(t *testing.T) {
+	src := newTestSource()
+	src.Append(testDB, "error/postgresql.log.00", "seen\n")
+	store := newMemoryStore()
+	store.Save(testDB, rdstail.Checkpoint{
+		LogFileName: "error/postgresql.log.00",
+		LastWritten: src.LastWritten(testDB, "error/postgresql.log.00"),
+		Marker:      strconv.Itoa(len("seen\n")),
+	})
+	src.Append(testDB, "error/postgresql.log.00", "tail of 00\n")
+	src.Append(testDB, "error/postgresql.log.01", "all of 01\n")
+
+	w := startWatch(t, src, store)
+	w.waitOutput(t, "tail of 00\nall of 01\n")
+	w.finish(t)
+
+	checkMarker(t, src, store, "error/postgresql.log.01", len("all of 01\n"))
+}
+
+func TestWatchDrainsRotations(t *testing.T) {
+	src := newTestSource()
+	src.Append(testDB, "error/postgresql.log.00", "start\n")
+	store := newMemoryStore()
+
+	w := startWatch(t, src, store)
+	w.waitStarted(t)
+	src.Append(testDB, "error/postgresql.log.00", "in 00\n")
+	w.waitOutput(t, "in 00\n")
+
+	// Several rotations between two polls
+	src.batch(func() {
+		src.Append(testDB, "error/postgresql.log.00", "end of 00\n")
+		src.Append(testDB, "error/postgresql.log.01", "all of 01\n")
+		src.Append(testDB, "error/postgresql.log.02", "all of 02\n")
+		src.Append(testDB, "error/postgresql.log.03", "start of 03\n")
+	})
+	w.waitOutput(t, "in 00\nend of 00\nall of 01\nall of 02\nstart of 03\n")
+	checkMarker(t, src, store, "error/postgresql.log.03", len("start of 03\n"))
+
+	src.Append(testDB, "error/postgresql.log.03", "more of 03\n")
+	w.waitOutput(t, "in 00\nend of 00\nall of 01\nall of 02\nstart of 03\nmore of 03\n")
+	w.finish(t)
+
+	want := []string{"error/postgresql.log.00", "error/postgresql.log.01", "error/postgresql.log.02", "error/postgresql.log.03"}
+	if got := w.files(); strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("lines read from %v, want %v", got, want)
+	}
+	checkMarker(t, src, store, "error/postgresql.log.03", len("start of 03\nmore of 03\n"))
+}
+
+func TestWatchOldFileWrittenAfterRotation(t *testing.T) {
+	src := newTestSource()
+	src.Append(testDB, "error/postgresql.log.00", "start\n")
+	store := newMemoryStore()
+
+	w := startWatch(t, src, store)
+	w.waitStarted(t)
+
+	// The old file is still being written after the new one appeared, as
+	// the server finishes off what it was logging at the rotation
+	src.batch(func() {
+		src.Append(testDB, "error/postgresql.log.01", "first of 01\n")
+		src.Append(testDB, "error/postgresql.log.00", "late 1 in 00\n")
+		src.Append(testDB, "error/postgresql.log.00", "late 2 in 00\n")
+		src.Append(testDB, "error/postgresql.log.01", "second of 01\n")
+	})
+	w.waitOutput(t, "late 1 in 00\nlate 2 in 00\nfirst of 01\nsecond of 01\n")
+	w.finish(t)
+
+	want := []string{"error/postgresql.log.00", "error/postgresql.log.01"}
+	if got := w.files(); strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("lines read from %v, want %v", got, want)
+	}
+	checkMarker(t, src, store, "error/postgresql.log.01", len("first of 01\nsecond of 01\n"))
+}
+
+func TestWatchMarkerTextInLine(t *testing.T) {
+	src := newTestSource()
+	src.Append(testDB, "error/postgresql.log.00", "start\n")
+	store := newMemoryStore()
+
+	w := startWatch(t, src, store)
+	w.waitStarted(t)
+	lines := "LOG:  statement: select '" + rdstail.TruncationMarker + "'\nLOG:  next\n"
+	src.Append(testDB, "error/postgresql.log.00", lines)
+	w.waitOutput(t, lines)
+	w.finish(t)
+
+	if n := atomic.LoadInt32(&src.completes); n != 0 {
+		t.Errorf("marker text inside a line led to %d complete downloads", n)
+	}
+}
+
+func TestWatchRecoversTruncated(t *testing.T) {
+	src := newTestSource()
+	src.TruncateAt = 4
+	src.Append(testDB, "error/postgresql.log.00", "a\nb\n")
+	store := newMemoryStore()
+
+	w := startWatch(t, src, store)
+	w.waitStarted(t)
+	// What's left of the truncated portion also matches the start of the file
+	src.Append(testDB, "error/postgresql.log.00", "a\nb\na long line\n")
+	w.waitOutput(t, "a\nb\na long line\n")
+	w.finish(t)
+
+	if n := atomic.LoadInt32(&src.completes); n != 1 {
+		t.Errorf("got %d complete downloads, want 1", n)
+	}
+	checkMarker(t, src, store, "error/postgresql.log.00", len("a\nb\na\nb\na long line\n"))
+}
+
+func TestWatchRecoversTruncatedRepeatedBlock(t *testing.T) {
+	src := newTestSource()
+	src.TruncateAt = 4
+	src.Append(testDB, "error/postgresql.log.00", "start\n")
+	store := newMemoryStore()
+
+	w := startWatch(t, src, store)
+	w.waitStarted(t)
+	// What's left of the truncated portion matches its own start and the
+	// copy of the block after it
+	block := "a\nb\nc\n"
+	src.Append(testDB, "error/postgresql.log.00", block+block)
+	w.waitOutput(t, block+block)
+	w.finish(t)
+
+	checkMarker(t, src, store, "error/postgresql.log.00", len("start\n"+block+block))
+}
+
+func TestWatchDrainsLongRotatedFile(t *testing.T) {
+	src := newTestSource()
+	src.PageSize = 64 * 1024
+	src.Append(testDB, "error/postgresql.log.00", "start\n")
+	store := newMemoryStore()
+
+	w := startWatch(t, src, store)
+	w.waitStarted(t)
+
+	// A busy file rotated in and out between two polls
+	long := manyLines("in 01")
+	src.batch(func() {
+		src.Append(testDB, "error/postgresql.log.01", long)
+		src.Append(testDB, "error/postgresql.log.02", "start of 02\n")
+	})
+	w.waitOutput(t, long+"start of 02\n")
+	w.finish(t)
+
+	checkMarker(t, src, store, "error/postgresql.log.02", len("start of 02\n"))
+}
+
+func TestWatchResumesIntoLongFile(t *testing.T) {
+	src := newTestSource()
+	src.PageSize = 64 * 1024
+	src.Append(testDB, "error/postgresql.log.00", "seen\n")
+	store := newMemoryStore()
+	store.Save(testDB, rdstail.Checkpoint{
+		LogFileName: "error/postgresql.log.00",
+		LastWritten: src.LastWritten(testDB, "error/postgresql.log.00"),
+		Marker:      strconv.Itoa(len("seen\n")),
+	})
+
+	// While stopped the checkpointed file expired and a busy one followed
+	long := manyLines("in 01")
+	src.Append(testDB, "error/postgresql.log.01", long)
+	src.Remove(testDB, "error/postgresql.log.00")
+
+	w := startWatch(t, src, store)
+	w.waitOutput(t, long)
+	w.finish(t)
+
+	checkMarker(t, src, store, "error/postgresql.log.01", len(long))
+}
@@ -0,0 +1,76 @@
+package rdstailtest
+
+import (
+	"sync"
+
+	"github.com/litl/rdstail/src"
+)
+
+var _ rdstail.KafkaProducer = (*KafkaBroker)(nil)
+
+// KafkaBroker is an in-memory stand-in for a Kafka cluster, to hand to
+// rdstail.NewKafkaSink in place of a real producer.
+type KafkaBroker struct {
+	mu     sync.Mutex
+	topics map[string][]rdstail.KafkaMessage
+	err    error
+	closed bool
+}
+
+func NewKafkaBroker() *KafkaBroker {
+	return &KafkaBroker{topics: make(map[string][]rdstail.KafkaMessage)}
+}
+
+// SendMessages appends msgs to their topics, or returns the error set by
+// Fail without taking any.
+func (b *KafkaBroker) SendMessages(msgs []*rdstail.KafkaMessage) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.err != nil {
+		return b.err
+	}
+	for _, m := range msgs {
+		b.topics[m.Topic] = append(b.topics[m.Topic], *m)
+	}
+	return nil
+}
+
+// Fail makes SendMessages return err until called again with nil.
+func (b *KafkaBroker) Fail(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.err = err
+}
+
+// Messages returns what was published to topic, in order.
+func (b *KafkaBroker) Messages(topic string) []rdstail.KafkaMessage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]rdstail.KafkaMessage(nil), b.topics[topic]...)
+}
+
+// Keyed returns what was published to topic with key, in order, as a
+// partition keyed by instance would hold it.
+func (b *KafkaBroker) Keyed(topic, key string) []rdstail.KafkaMessage {
+	var msgs []rdstail.KafkaMessage
+	for _, m := range b.Messages(topic) {
+		if string(m.Key) == key {
+			msgs = append(msgs, m)
+		}
+	}
+	return msgs
+}
+
+func (b *KafkaBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	return nil
+}
+
+// Closed tells whether the sink closed the broker.
+func (b *KafkaBroker) Closed() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.closed
+}
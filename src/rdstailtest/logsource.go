@@ -0,0 +1,430 @@
+// Package rdstailtest provides an in-memory stand-in for the RDS log API so
+// code built on rdstail can run without an AWS account.
+package rdstailtest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/litl/rdstail/src"
+)
+
+var (
+	_ rdstail.LogSource         = (*LogSource)(nil)
+	_ rdstail.InstanceSource    = (*LogSource)(nil)
+	_ rdstail.CompleteLogSource = (*LogSource)(nil)
+)
+
+const (
+	// DefaultPageSize is the number of bytes returned per log file portion.
+	DefaultPageSize = 4096
+	// DefaultDescribePageSize is the number of files returned per describe page.
+	DefaultDescribePageSize = 100
+	// TailLines is how many lines at the end of a file a portion request
+	// without a marker or NumberOfLines starts at, as with RDS.
+	TailLines = 10000
+)
+
+type instance struct {
+	engine string
+	tags   map[string]string
+}
+
+type cluster struct {
+	engine  string
+	writer  string
+	readers []string
+}
+
+type logFile struct {
+	name        string
+	data        []byte
+	lastWritten int64
+}
+
+// LogSource is an in-memory rdstail.LogSource.  Log files can be appended to,
+// created and removed while a Watch is running against it, which simulates
+// files growing, rotating and expiring on a real instance.
+//
+// Markers are opaque strings holding a byte offset into the file, "0" for its
+// start.  As with RDS, a portion request without a marker only reads the last
+// TailLines lines.  Both log file listings and log file portions are
+// paginated.
+type LogSource struct {
+	// PageSize is the maximum number of bytes in a log file portion.
+	PageSize int
+	// DescribePageSize is the maximum number of files in a listing page.
+	DescribePageSize int
+	// TruncateAt, if set, makes log file portions behave like RDS does past
+	// its 1 MB limit: a page longer than this is cut off with
+	// rdstail.TruncationMarker and the rest of it is skipped.
+	TruncateAt int
+
+	mu        sync.Mutex
+	instances map[string][]*logFile
+	meta      map[string]*instance
+	clusters  map[string]*cluster
+	clock     int64
+}
+
+// NewLogSource returns an empty LogSource using the default page sizes.
+func NewLogSource() *LogSource {
+	return &LogSource{
+		PageSize:         DefaultPageSize,
+		DescribePageSize: DefaultDescribePageSize,
+		instances:        make(map[string][]*logFile),
+		meta:             make(map[string]*instance),
+		clusters:         make(map[string]*cluster),
+	}
+}
+
+// AddInstance registers a db instance without any log files.
+func (s *LogSource) AddInstance(db string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.instance(db)
+}
+
+// SetEngine sets the engine reported for a db instance.
+func (s *LogSource) SetEngine(db, engine string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.instance(db).engine = engine
+}
+
+// SetTags replaces the tags of a db instance.
+func (s *LogSource) SetTags(db string, tags map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.instance(db).tags = tags
+}
+
+// RemoveInstance deletes a db instance along with its log files.
+func (s *LogSource) RemoveInstance(db string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.instances, db)
+	delete(s.meta, db)
+}
+
+// SetCluster creates or replaces an Aurora cluster with the given writer and
+// readers, registering them as instances.  Calling it again with the roles
+// swapped simulates a failover.
+func (s *LogSource) SetCluster(id, engine, writer string, readers ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, db := range append([]string{writer}, readers...) {
+		s.instance(db).engine = engine
+	}
+	s.clusters[id] = &cluster{engine: engine, writer: writer, readers: readers}
+}
+
+func (s *LogSource) instance(db string) *instance {
+	if _, ok := s.instances[db]; !ok {
+		s.instances[db] = nil
+	}
+	m, ok := s.meta[db]
+	if !ok {
+		m = &instance{}
+		s.meta[db] = m
+	}
+	return m
+}
+
+// Append writes data to the end of the named log file, creating the file (and
+// instance) if needed.  Creating a new file is how a rotation is simulated.
+func (s *LogSource) Append(db, name, data string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f := s.file(db, name)
+	if f == nil {
+		f = &logFile{name: name}
+		s.instances[db] = append(s.instances[db], f)
+	}
+	f.data = append(f.data, data...)
+	f.lastWritten = s.tick()
+}
+
+// Remove deletes a log file, as RDS does once it passes the retention period.
+func (s *LogSource) Remove(db, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files := s.instances[db]
+	for i, f := range files {
+		if f.name == name {
+			s.instances[db] = append(files[:i:i], files[i+1:]...)
+			return
+		}
+	}
+}
+
+// LastWritten returns the LastWritten value of the named log file, or 0 if it
+// does not exist.
+func (s *LogSource) LastWritten(db, name string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if f := s.file(db, name); f != nil {
+		return f.lastWritten
+	}
+	return 0
+}
+
+// tick returns a strictly increasing epoch millisecond timestamp so that every
+// write is distinguishable by LastWritten, even within the same millisecond.
+func (s *LogSource) tick() int64 {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	if now <= s.clock {
+		now = s.clock + 1
+	}
+	s.clock = now
+	return now
+}
+
+func (s *LogSource) file(db, name string) *logFile {
+	for _, f := range s.instances[db] {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *LogSource) DescribeDBInstancesPages(req *rds.DescribeDBInstancesInput, fn func(*rds.DescribeDBInstancesOutput, bool) bool) error {
+	s.mu.Lock()
+	var ids []string
+	for db := range s.instances {
+		if req.DBInstanceIdentifier == nil || *req.DBInstanceIdentifier == db {
+			ids = append(ids, db)
+		}
+	}
+	sort.Strings(ids)
+
+	page := &rds.DescribeDBInstancesOutput{}
+	for _, id := range ids {
+		inst := &rds.DBInstance{
+			DBInstanceIdentifier: aws.String(id),
+			DBInstanceArn:        aws.String(instanceARN(id)),
+		}
+		if m := s.meta[id]; m != nil && m.engine != "" {
+			inst.Engine = aws.String(m.engine)
+		}
+		page.DBInstances = append(page.DBInstances, inst)
+	}
+	s.mu.Unlock()
+	fn(page, true)
+	return nil
+}
+
+func (s *LogSource) DescribeDBClustersPages(req *rds.DescribeDBClustersInput, fn func(*rds.DescribeDBClustersOutput, bool) bool) error {
+	s.mu.Lock()
+	var ids []string
+	for id := range s.clusters {
+		if req.DBClusterIdentifier == nil || *req.DBClusterIdentifier == id {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	page := &rds.DescribeDBClustersOutput{}
+	for _, id := range ids {
+		c := s.clusters[id]
+		out := &rds.DBCluster{
+			DBClusterIdentifier: aws.String(id),
+			Engine:              aws.String(c.engine),
+		}
+		out.DBClusterMembers = append(out.DBClusterMembers, &rds.DBClusterMember{
+			DBInstanceIdentifier: aws.String(c.writer),
+			IsClusterWriter:      aws.Bool(true),
+		})
+		for _, db := range c.readers {
+			out.DBClusterMembers = append(out.DBClusterMembers, &rds.DBClusterMember{
+				DBInstanceIdentifier: aws.String(db),
+				IsClusterWriter:      aws.Bool(false),
+			})
+		}
+		page.DBClusters = append(page.DBClusters, out)
+	}
+	s.mu.Unlock()
+
+	if req.DBClusterIdentifier != nil && len(ids) == 0 {
+		return awserr.New("DBClusterNotFoundFault", fmt.Sprintf("DBCluster %s not found.", *req.DBClusterIdentifier), nil)
+	}
+	fn(page, true)
+	return nil
+}
+
+func (s *LogSource) ListTagsForResource(req *rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	arn := aws.StringValue(req.ResourceName)
+	for db := range s.instances {
+		if instanceARN(db) != arn {
+			continue
+		}
+		out := &rds.ListTagsForResourceOutput{}
+		if m := s.meta[db]; m != nil {
+			for k, v := range m.tags {
+				out.TagList = append(out.TagList, &rds.Tag{Key: aws.String(k), Value: aws.String(v)})
+			}
+		}
+		return out, nil
+	}
+	return nil, awserr.New("DBInstanceNotFound", fmt.Sprintf("DBInstance %s not found.", arn), nil)
+}
+
+func instanceARN(db string) string {
+	return "arn:aws:rds:us-east-1:123456789012:db:" + db
+}
+
+func (s *LogSource) DescribeDBLogFilesPages(req *rds.DescribeDBLogFilesInput, fn func(*rds.DescribeDBLogFilesOutput, bool) bool) error {
+	s.mu.Lock()
+	files, ok := s.instances[aws.StringValue(req.DBInstanceIdentifier)]
+	var details []*rds.DescribeDBLogFilesDetails
+	for _, f := range files {
+		if req.FileLastWritten != nil && f.lastWritten < *req.FileLastWritten {
+			continue
+		}
+		if req.FilenameContains != nil && !strings.Contains(f.name, *req.FilenameContains) {
+			continue
+		}
+		details = append(details, &rds.DescribeDBLogFilesDetails{
+			LogFileName: aws.String(f.name),
+			LastWritten: aws.Int64(f.lastWritten),
+			Size:        aws.Int64(int64(len(f.data))),
+		})
+	}
+	pageSize := s.DescribePageSize
+	s.mu.Unlock()
+
+	if !ok {
+		return awserr.New("DBInstanceNotFound", fmt.Sprintf("DBInstance %s not found.", aws.StringValue(req.DBInstanceIdentifier)), nil)
+	}
+	sort.Sort(byName(details))
+	if pageSize <= 0 {
+		pageSize = DefaultDescribePageSize
+	}
+
+	for {
+		n := len(details)
+		if n > pageSize {
+			n = pageSize
+		}
+		page := &rds.DescribeDBLogFilesOutput{DescribeDBLogFiles: details[:n]}
+		details = details[n:]
+		lastPage := len(details) == 0
+		if !lastPage {
+			page.Marker = aws.String(strconv.Itoa(n))
+		}
+		if !fn(page, lastPage) || lastPage {
+			return nil
+		}
+	}
+}
+
+func (s *LogSource) DownloadDBLogFilePortionPages(req *rds.DownloadDBLogFilePortionInput, fn func(*rds.DownloadDBLogFilePortionOutput, bool) bool) error {
+	db, name := aws.StringValue(req.DBInstanceIdentifier), aws.StringValue(req.LogFileName)
+
+	offset := 0
+	tail := req.Marker == nil || *req.Marker == ""
+	if !tail {
+		var err error
+		offset, err = strconv.Atoi(*req.Marker)
+		if err != nil {
+			return awserr.New("InvalidParameterValue", fmt.Sprintf("invalid marker %q", *req.Marker), err)
+		}
+	}
+
+	for {
+		s.mu.Lock()
+		f := s.file(db, name)
+		if f == nil {
+			s.mu.Unlock()
+			return awserr.New("DBLogFileNotFoundFault", fmt.Sprintf("DBLog File: %s, is not found on the DB instance", name), nil)
+		}
+		data := f.data
+		pageSize := s.PageSize
+		truncateAt := s.TruncateAt
+		s.mu.Unlock()
+
+		if offset > len(data) {
+			offset = len(data)
+		}
+		if tail {
+			// Like RDS, without a marker only the end of the file is read
+			lines := TailLines
+			if req.NumberOfLines != nil {
+				lines = int(*req.NumberOfLines)
+			}
+			offset = lastLinesOffset(data, lines)
+			tail = false
+		}
+		if pageSize <= 0 {
+			pageSize = DefaultPageSize
+		}
+
+		end := offset + pageSize
+		if end >= len(data) {
+			end = len(data)
+		} else if nl := strings.LastIndexByte(string(data[offset:end]), '\n'); nl >= 0 {
+			// Like RDS, only hand out whole lines when a page boundary allows it
+			end = offset + nl + 1
+		}
+
+		portion := string(data[offset:end])
+		if truncateAt > 0 && len(portion) > truncateAt {
+			portion = portion[:truncateAt] + rdstail.TruncationMarker
+		}
+
+		pending := end < len(data)
+		page := &rds.DownloadDBLogFilePortionOutput{
+			LogFileData:           aws.String(portion),
+			Marker:                aws.String(strconv.Itoa(end)),
+			AdditionalDataPending: aws.Bool(pending),
+		}
+		offset = end
+		if !fn(page, !pending) || !pending {
+			return nil
+		}
+	}
+}
+
+// DownloadCompleteLogFile returns the whole of a log file, never truncated.
+func (s *LogSource) DownloadCompleteLogFile(db, name string) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f := s.file(db, name)
+	if f == nil {
+		return nil, awserr.New("DBLogFileNotFoundFault", fmt.Sprintf("DBLog File: %s, is not found on the DB instance", name), nil)
+	}
+	return ioutil.NopCloser(bytes.NewReader(append([]byte(nil), f.data...))), nil
+}
+
+// lastLinesOffset returns the offset of the start of the last n lines of data.
+func lastLinesOffset(data []byte, n int) int {
+	s := strings.TrimSuffix(string(data), "\n")
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == '\n' {
+			n--
+			if n == 0 {
+				return i + 1
+			}
+		}
+	}
+	return 0
+}
+
+type byName []*rds.DescribeDBLogFilesDetails
+
+func (b byName) Len() int           { return len(b) }
+func (b byName) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byName) Less(i, j int) bool { return *b[i].LogFileName < *b[j].LogFileName }
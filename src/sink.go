@@ -0,0 +1,197 @@
+package rdstail
+
+import (
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/litl/rdstail/src/parser"
+)
+
+// Event is a parsed log entry along with where it was read from.
+type Event struct {
+	Instance Instance
+	LogFile  string
+	parser.Event
+}
+
+// Timestamp is the time of the entry, or fallback when the log line didn't
+// carry one.
+func (e *Event) Timestamp(fallback time.Time) time.Time {
+	if e.Time.IsZero() {
+		return fallback
+	}
+	return e.Time
+}
+
+// Sink is a destination for log events.
+type Sink interface {
+	// Send delivers events in order.  They only count as delivered, and the
+	// Watch moves on, once it returns nil.
+	Send(events []Event) error
+	Close() error
+}
+
+// Flusher is implemented by sinks that may still hold events back once Send
+// returns.  Flush sends whatever is held back.  Should that fail the events
+// are let go of, for the caller to send again.
+type Flusher interface {
+	Flush() error
+}
+
+// Document is the JSON form of an event sent by the HTTP and Elasticsearch
+// sinks.
+type Document struct {
+	Timestamp  time.Time         `json:"@timestamp"`
+	Instance   string            `json:"instance"`
+	Engine     string            `json:"engine,omitempty"`
+	Cluster    string            `json:"cluster,omitempty"`
+	Role       string            `json:"role,omitempty"`
+	LogFile    string            `json:"log_file"`
+	Severity   string            `json:"severity,omitempty"`
+	User       string            `json:"user,omitempty"`
+	Database   string            `json:"database,omitempty"`
+	ClientHost string            `json:"client_host,omitempty"`
+	PID        int               `json:"pid,omitempty"`
+	Message    string            `json:"message"`
+	Fields     map[string]string `json:"fields,omitempty"`
+}
+
+// NewDocument returns the document for e, timestamped fallback if the log
+// line had no time.
+func NewDocument(e *Event, fallback time.Time) *Document {
+	return &Document{
+		Timestamp:  e.Timestamp(fallback),
+		Instance:   e.Instance.ID,
+		Engine:     e.Instance.Engine,
+		Cluster:    e.Instance.Cluster,
+		Role:       e.Instance.Role,
+		LogFile:    e.LogFile,
+		Severity:   e.Severity,
+		User:       e.User,
+		Database:   e.Database,
+		ClientHost: e.ClientHost,
+		PID:        e.PID,
+		Message:    e.Message,
+		Fields:     e.Fields,
+	}
+}
+
+// LogFamily is the log file name without its rotation suffix, e.g. error for
+// error/postgresql.log.2015-03-13-05 and slowquery for
+// slowquery/mysql-slowquery.log.3.
+func LogFamily(logFile string) string {
+	if dir := path.Dir(logFile); dir != "." {
+		return dir
+	}
+	name := path.Base(logFile)
+	if i := strings.Index(name, ".log"); i >= 0 {
+		return name[:i]
+	}
+	return name
+}
+
+// ExpandTemplate fills in the {instance}, {engine}, {cluster}, {role},
+// {logfile} and {logtype} placeholders of tmpl from e.
+func ExpandTemplate(tmpl string, e *Event) string {
+	if !strings.Contains(tmpl, "{") {
+		return tmpl
+	}
+	return strings.NewReplacer(
+		"{instance}", e.Instance.ID,
+		"{engine}", e.Instance.Engine,
+		"{cluster}", e.Instance.Cluster,
+		"{role}", e.Instance.Role,
+		"{logfile}", e.LogFile,
+		"{logtype}", LogFamily(e.LogFile),
+	).Replace(tmpl)
+}
+
+// feedParser parses the lines of one log file of an instance for Feed.
+type feedParser struct {
+	inst    Instance
+	logFile string
+	parser.Parser
+}
+
+func (p *feedParser) events(parsed []parser.Event) []Event {
+	events := make([]Event, len(parsed))
+	for i, e := range parsed {
+		events[i] = Event{Instance: p.inst, LogFile: p.logFile, Event: e}
+	}
+	return events
+}
+
+// Feed watches the instances picked by sel like WatchInstances does, parses
+// what it reads and sends the events to sink.  PostgreSQL logs are parsed
+// with pgPrefix, or parser.DefaultPostgresPrefix if it is empty.
+func Feed(r Client, sel InstanceSelector, patterns []string, refresh, rate time.Duration, store CheckpointStore, pgPrefix string, sink Sink, stop <-chan struct{}) error {
+	// A parser holds the last entry back until a line shows it is complete,
+	// or Watch reports its file quiet or left behind.  The checkpoint has
+	// moved past the entry's lines by then, so a failed send is retried here
+	// rather than by restarting the Watch, which would parse them again.
+	parsers := make(map[string]*feedParser)
+	send := func(p *feedParser, parsed []parser.Event) error {
+		if len(parsed) == 0 {
+			return nil
+		}
+		events := p.events(parsed)
+		for {
+			err := sink.Send(events)
+			if err == nil {
+				return nil
+			}
+			log.Printf("%s: %s: %s, retrying in %s", p.inst.ID, p.logFile, err, watchRestartWait)
+			select {
+			case <-time.After(watchRestartWait):
+			case <-stop:
+				return err
+			}
+		}
+	}
+
+	err := WatchInstances(r, sel, patterns, refresh, rate, store, func(inst Instance, logFile, lines string) error {
+		key := inst.ID + " " + logFile
+		p, ok := parsers[key]
+		if lines == "" {
+			if !ok {
+				return nil
+			}
+			p.inst = inst
+			return send(p, p.Flush())
+		}
+		if !ok {
+			// A new file of the same log means the one before is done with
+			family := LogFamily(logFile)
+			for k, old := range parsers {
+				if old.inst.ID == inst.ID && LogFamily(old.logFile) == family {
+					delete(parsers, k)
+					if err := send(old, old.Flush()); err != nil {
+						return err
+					}
+				}
+			}
+			np, err := parser.ForFile(logFile, pgPrefix)
+			if err != nil {
+				return err
+			}
+			p = &feedParser{logFile: logFile, Parser: np}
+			parsers[key] = p
+		}
+		p.inst = inst
+		return send(p, p.Parse(lines))
+	}, stop)
+
+	// Nothing more is coming, send what is still held back
+	for _, p := range parsers {
+		parsed := p.Flush()
+		if len(parsed) == 0 {
+			continue
+		}
+		if err := sink.Send(p.events(parsed)); err != nil {
+			log.Printf("%s: %s: %s, dropping %d held back events", p.inst.ID, p.logFile, err, len(parsed))
+		}
+	}
+	return err
+}
@@ -0,0 +1,72 @@
+package rdstail_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/litl/rdstail/src"
+)
+
+type recordSink struct {
+	mu     sync.Mutex
+	events []rdstail.Event
+}
+
+func (s *recordSink) Send(events []rdstail.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func (s *recordSink) Close() error {
+	return nil
+}
+
+func (s *recordSink) sent() []rdstail.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]rdstail.Event(nil), s.events...)
+}
+
+func TestFeedJoinsEntryAcrossPolls(t *testing.T) {
+	src := newTestSource()
+	src.Append(testDB, "error/postgresql.log.00", "2020-01-01 10:00:00 UTC::@:[1]:LOG:  start\n")
+	sink := &recordSink{}
+	stop := make(chan struct{})
+	done := make(chan error, 1)
+	sel := rdstail.InstanceSelector{Names: []string{testDB}}
+	go func() {
+		done <- rdstail.Feed(src, sel, nil, 0, 50*time.Millisecond, newMemoryStore(), "", sink, stop)
+	}()
+	waitUntil(t, "feed to start", func() bool { return atomic.LoadInt32(&src.downloads) > 0 })
+
+	// The error is read in one poll and its statement in the next
+	var read int32
+	src.batch(func() {
+		src.Append(testDB, "error/postgresql.log.00", "2020-01-01 10:00:01 UTC:10.0.0.1(5432):alice@app:[1234]:ERROR:  oops\n")
+		read = atomic.LoadInt32(&src.downloads)
+	})
+	waitUntil(t, "the error to be read", func() bool { return atomic.LoadInt32(&src.downloads) > read })
+	src.Append(testDB, "error/postgresql.log.00", "2020-01-01 10:00:01 UTC:10.0.0.1(5432):alice@app:[1234]:STATEMENT:  select 1\n"+
+		"2020-01-01 10:00:02 UTC::@:[1]:LOG:  next\n")
+
+	waitUntil(t, "both entries", func() bool { return len(sink.sent()) >= 2 })
+	close(stop)
+	if err := <-done; err != nil {
+		t.Fatalf("Feed: %s", err)
+	}
+
+	events := sink.sent()
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2: %#v", len(events), events)
+	}
+	if e := events[0]; e.Severity != "ERROR" || e.Fields["statement"] != "select 1" {
+		t.Errorf("got %#v, want the error with its statement", e)
+	}
+	if e := events[1]; e.Message != "next" || e.Instance.ID != testDB || e.LogFile != "error/postgresql.log.00" {
+		t.Errorf("got %#v, want the next entry", e)
+	}
+}
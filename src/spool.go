@@ -0,0 +1,380 @@
+package rdstail
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	spoolSuffix = ".spool"
+	// Segments are rotated when they reach spoolSegmentSize or
+	// spoolSegmentAge, so what is sent can be deleted and the age limit can
+	// drop old data a segment at a time
+	spoolSegmentSize = 8 * 1024 * 1024
+	spoolSegmentAge  = 10 * time.Minute
+	// spoolBatchSize is the most events handed to the sink at once
+	spoolBatchSize = 1000
+
+	spoolRetryMin = time.Second
+	spoolRetryMax = time.Minute
+)
+
+// Spool is a queue of events on disk, between Feed and a sink.  As a Sink it
+// only returns once the events are written and synced, so the RDS marker only
+// moves on for events that are safely queued, and Drain sends them on at the
+// pace of the real sink.  A sink that is down for a while only holds up
+// Drain, nothing is lost.
+//
+// Events are kept one JSON object per line in numbered segment files, and how
+// far Drain has got in a cursor file next to them.
+type Spool struct {
+	dir     string
+	maxSize int64
+	maxAge  time.Duration
+	notify  chan struct{}
+
+	mu       sync.Mutex
+	segments []*spoolSegment
+	active   *os.File
+	cursor   spoolCursor
+}
+
+type spoolSegment struct {
+	id      int64
+	size    int64
+	created time.Time
+	written time.Time
+}
+
+type spoolCursor struct {
+	Segment int64 `json:"segment"`
+	Offset  int64 `json:"offset"`
+}
+
+// OpenSpool opens the spool in dir, creating it if need be.  Once it holds
+// maxSize bytes Send fails, holding back the Watches, and segments last
+// written more than maxAge ago are dropped unsent.  Zero leaves either
+// unlimited.
+func OpenSpool(dir string, maxSize int64, maxAge time.Duration) (*Spool, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	s := &Spool{dir: dir, maxSize: maxSize, maxAge: maxAge, notify: make(chan struct{}, 1)}
+
+	names, err := filepath.Glob(filepath.Join(dir, "*"+spoolSuffix))
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		id, err := strconv.ParseInt(strings.TrimSuffix(filepath.Base(name), spoolSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		fi, err := os.Stat(name)
+		if err != nil {
+			return nil, err
+		}
+		s.segments = append(s.segments, &spoolSegment{id: id, size: fi.Size(), created: fi.ModTime(), written: fi.ModTime()})
+	}
+	sort.Sort(bySegmentID(s.segments))
+	if n := len(s.segments); n > 0 {
+		if err := s.repair(s.segments[n-1]); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := ioutil.ReadFile(s.cursorPath())
+	if err == nil {
+		if err := json.Unmarshal(data, &s.cursor); err != nil {
+			return nil, fmt.Errorf("spool cursor %s: %s", s.cursorPath(), err)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if err := s.rotate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+type bySegmentID []*spoolSegment
+
+func (s bySegmentID) Len() int           { return len(s) }
+func (s bySegmentID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s bySegmentID) Less(i, j int) bool { return s[i].id < s[j].id }
+
+func (s *Spool) segmentPath(id int64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%016d%s", id, spoolSuffix))
+}
+
+func (s *Spool) cursorPath() string {
+	return filepath.Join(s.dir, "cursor")
+}
+
+// repair cuts off a line left half written by a crash.
+func (s *Spool) repair(seg *spoolSegment) error {
+	data, err := ioutil.ReadFile(s.segmentPath(seg.id))
+	if err != nil {
+		return err
+	}
+	end := int64(bytes.LastIndexByte(data, '\n') + 1)
+	if end == seg.size {
+		return nil
+	}
+	log.Printf("spool: %s: dropping %d bytes of a half written event", s.segmentPath(seg.id), seg.size-end)
+	seg.size = end
+	return os.Truncate(s.segmentPath(seg.id), end)
+}
+
+// rotate starts a new segment to append to.  Must be called with mu held,
+// or before the spool is shared.
+func (s *Spool) rotate() error {
+	id := int64(1)
+	if n := len(s.segments); n > 0 {
+		id = s.segments[n-1].id + 1
+	}
+	f, err := os.OpenFile(s.segmentPath(id), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	if err := syncDir(s.dir); err != nil {
+		f.Close()
+		return err
+	}
+	if s.active != nil {
+		s.active.Close()
+	}
+	now := time.Now()
+	s.active = f
+	s.segments = append(s.segments, &spoolSegment{id: id, created: now, written: now})
+	return nil
+}
+
+// syncDir makes the creation of files in dir durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// Send appends events to the spool and syncs them to disk.
+func (s *Spool) Send(events []Event) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for i := range events {
+		if err := enc.Encode(&events[i]); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expire()
+
+	if s.maxSize > 0 && s.size()+int64(buf.Len()) > s.maxSize {
+		return fmt.Errorf("spool %s is full, waiting for it to drain", s.dir)
+	}
+	seg := s.segments[len(s.segments)-1]
+	if seg.size >= spoolSegmentSize || (seg.size > 0 && time.Since(seg.created) >= spoolSegmentAge) {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+		seg = s.segments[len(s.segments)-1]
+	}
+
+	if _, err := s.active.Write(buf.Bytes()); err != nil {
+		// Don't leave part of the events behind to be read as a broken line
+		s.active.Truncate(seg.size)
+		return err
+	}
+	if err := s.active.Sync(); err != nil {
+		s.active.Truncate(seg.size)
+		return err
+	}
+	seg.size += int64(buf.Len())
+	seg.written = time.Now()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// size is how many bytes are waiting to be drained.  Must be called with mu
+// held.
+func (s *Spool) size() int64 {
+	var n int64
+	for _, seg := range s.segments {
+		switch {
+		case seg.id > s.cursor.Segment:
+			n += seg.size
+		case seg.id == s.cursor.Segment:
+			n += seg.size - s.cursor.Offset
+		}
+	}
+	return n
+}
+
+// expire drops the segments written longer than maxAge ago.  Must be called
+// with mu held.
+func (s *Spool) expire() {
+	if s.maxAge <= 0 {
+		return
+	}
+	cutoff := time.Now().Add(-s.maxAge)
+	for len(s.segments) > 1 && s.segments[0].written.Before(cutoff) {
+		seg := s.segments[0]
+		if seg.id > s.cursor.Segment || (seg.id == s.cursor.Segment && s.cursor.Offset < seg.size) {
+			log.Printf("spool: %s: dropping %d bytes older than %s", s.segmentPath(seg.id), seg.size, s.maxAge)
+		}
+		os.Remove(s.segmentPath(seg.id))
+		s.segments = s.segments[1:]
+	}
+}
+
+// read returns up to max events from the cursor on, and the cursor after
+// them.
+func (s *Spool) read(max int) ([]Event, spoolCursor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expire()
+
+	cur := s.cursor
+	for _, seg := range s.segments {
+		if seg.id < cur.Segment {
+			continue
+		}
+		if seg.id > cur.Segment {
+			cur = spoolCursor{Segment: seg.id}
+		}
+		if cur.Offset < seg.size {
+			return s.readSegment(seg, cur, max)
+		}
+	}
+	return nil, cur, nil
+}
+
+func (s *Spool) readSegment(seg *spoolSegment, cur spoolCursor, max int) ([]Event, spoolCursor, error) {
+	f, err := os.Open(s.segmentPath(seg.id))
+	if err != nil {
+		return nil, cur, err
+	}
+	defer f.Close()
+	if _, err := f.Seek(cur.Offset, io.SeekStart); err != nil {
+		return nil, cur, err
+	}
+
+	var events []Event
+	r := bufio.NewReader(io.LimitReader(f, seg.size-cur.Offset))
+	for len(events) < max {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, cur, err
+		}
+		cur.Offset += int64(len(line))
+
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			log.Printf("spool: %s: skipping unreadable event: %s", s.segmentPath(seg.id), err)
+			continue
+		}
+		events = append(events, e)
+	}
+	return events, cur, nil
+}
+
+// commit records that everything before cur was sent, and deletes the
+// segments that are done with.
+func (s *Spool) commit(cur spoolCursor) error {
+	data, err := json.Marshal(cur)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := writeFileAtomic(s.cursorPath(), data); err != nil {
+		return err
+	}
+	s.cursor = cur
+	for len(s.segments) > 1 && s.segments[0].id < cur.Segment {
+		if err := os.Remove(s.segmentPath(s.segments[0].id)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		s.segments = s.segments[1:]
+	}
+	return nil
+}
+
+// Drain sends what is spooled on to sink until stop is closed.  Failures are
+// logged and retried, backing off exponentially, for as long as it takes.
+func (s *Spool) Drain(sink Sink, stop <-chan struct{}) {
+	failures := 0
+	for {
+		events, next, err := s.read(spoolBatchSize)
+		if err == nil && len(events) > 0 {
+			err = sink.Send(events)
+		}
+		// Batching sinks have to let go of the events before the cursor
+		// can move past them
+		if f, ok := sink.(Flusher); ok && err == nil && len(events) > 0 {
+			err = f.Flush()
+		}
+		if err == nil && next != s.cursor {
+			err = s.commit(next)
+		}
+
+		if err != nil {
+			delay := spoolRetryMin << uint(failures)
+			if delay > spoolRetryMax || delay <= 0 {
+				delay = spoolRetryMax
+			} else {
+				failures++
+			}
+			log.Printf("spool: %s, retrying in %s", err, delay)
+			select {
+			case <-time.After(delay):
+			case <-stop:
+				return
+			}
+			continue
+		}
+
+		failures = 0
+		if len(events) > 0 {
+			continue
+		}
+		select {
+		case <-s.notify:
+		case <-time.After(spoolRetryMin):
+		case <-stop:
+			return
+		}
+	}
+}
+
+// Close closes the segment being appended to.  Events that weren't drained
+// yet stay on disk for the next time the spool is opened.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.active.Close()
+}
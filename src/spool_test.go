@@ -0,0 +1,235 @@
+package rdstail_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/parser"
+)
+
+func spoolEvents(msgs ...string) []rdstail.Event {
+	events := make([]rdstail.Event, len(msgs))
+	for i, msg := range msgs {
+		events[i] = rdstail.Event{
+			Instance: rdstail.Instance{ID: testDB},
+			LogFile:  "error/postgresql.log.00",
+			Event:    parser.Event{Time: time.Now(), Message: msg},
+		}
+	}
+	return events
+}
+
+func tempSpool(t *testing.T) (dir string, cleanup func()) {
+	dir, err := ioutil.TempDir("", "rdstail-spool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func openSpool(t *testing.T, dir string, maxSize int64, maxAge time.Duration) *rdstail.Spool {
+	t.Helper()
+	spool, err := rdstail.OpenSpool(dir, maxSize, maxAge)
+	if err != nil {
+		t.Fatalf("OpenSpool: %s", err)
+	}
+	return spool
+}
+
+func spoolSend(t *testing.T, spool *rdstail.Spool, msgs ...string) {
+	t.Helper()
+	if err := spool.Send(spoolEvents(msgs...)); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+}
+
+func spoolSegments(t *testing.T, dir string) []string {
+	t.Helper()
+	names, err := filepath.Glob(filepath.Join(dir, "*.spool"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return names
+}
+
+type sentSink interface {
+	rdstail.Sink
+	sent() []rdstail.Event
+}
+
+// drainSpool drains spool into sink until it has sent n events, and checks
+// they are want.
+func drainSpool(t *testing.T, spool *rdstail.Spool, sink sentSink, n int, want ...string) {
+	t.Helper()
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		spool.Drain(sink, stop)
+		close(done)
+	}()
+	waitUntil(t, "the spool to drain", func() bool { return len(sink.sent()) >= n })
+	close(stop)
+	<-done
+
+	var got []string
+	for _, e := range sink.sent() {
+		got = append(got, e.Message)
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("drained %q, want %q", got, want)
+	}
+}
+
+func TestSpoolRollsOverSegments(t *testing.T) {
+	dir, cleanup := tempSpool(t)
+	defer cleanup()
+	spool := openSpool(t, dir, 0, 0)
+	defer spool.Close()
+
+	// Eight megabyte sized events fill a segment, the ninth starts another
+	big := strings.Repeat("x", 1024*1024)
+	var want []string
+	for i := 0; i < 9; i++ {
+		msg := string('a'+rune(i)) + big
+		spoolSend(t, spool, msg)
+		want = append(want, msg)
+	}
+	if n := len(spoolSegments(t, dir)); n != 2 {
+		t.Fatalf("spool has %d segments, want 2", n)
+	}
+
+	drainSpool(t, spool, &recordSink{}, len(want), want...)
+	if n := len(spoolSegments(t, dir)); n != 1 {
+		t.Errorf("spool has %d segments once drained, want the full one deleted", n)
+	}
+}
+
+func TestSpoolCursorSurvivesReopen(t *testing.T) {
+	dir, cleanup := tempSpool(t)
+	defer cleanup()
+
+	spool := openSpool(t, dir, 0, 0)
+	spoolSend(t, spool, "one", "two")
+	drainSpool(t, spool, &recordSink{}, 2, "one", "two")
+	spoolSend(t, spool, "three")
+	spool.Close()
+
+	// What was drained isn't sent again, what wasn't still is
+	spool = openSpool(t, dir, 0, 0)
+	defer spool.Close()
+	spoolSend(t, spool, "four")
+	drainSpool(t, spool, &recordSink{}, 2, "three", "four")
+}
+
+func TestSpoolRepairsTornLine(t *testing.T) {
+	dir, cleanup := tempSpool(t)
+	defer cleanup()
+
+	spool := openSpool(t, dir, 0, 0)
+	spoolSend(t, spool, "one")
+	spool.Close()
+	segments := spoolSegments(t, dir)
+	if len(segments) != 1 {
+		t.Fatalf("spool has %d segments, want 1", len(segments))
+	}
+	whole, err := ioutil.ReadFile(segments[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A crash leaves an event half written
+	f, err := os.OpenFile(segments[0], os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(`{"Instance":{"ID":"db"`)
+	f.Close()
+
+	spool = openSpool(t, dir, 0, 0)
+	defer spool.Close()
+	repaired, err := ioutil.ReadFile(segments[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(repaired) != string(whole) {
+		t.Errorf("reopening left the segment as %q, want %q", repaired, whole)
+	}
+	spoolSend(t, spool, "two")
+	drainSpool(t, spool, &recordSink{}, 2, "one", "two")
+}
+
+func TestSpoolExpiresOldSegments(t *testing.T) {
+	dir, cleanup := tempSpool(t)
+	defer cleanup()
+
+	spool := openSpool(t, dir, 0, time.Hour)
+	spoolSend(t, spool, "old")
+	spool.Close()
+	long := time.Now().Add(-2 * time.Hour)
+	for _, name := range spoolSegments(t, dir) {
+		if err := os.Chtimes(name, long, long); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	spool = openSpool(t, dir, 0, time.Hour)
+	defer spool.Close()
+	spoolSend(t, spool, "new")
+	drainSpool(t, spool, &recordSink{}, 1, "new")
+	if n := len(spoolSegments(t, dir)); n != 1 {
+		t.Errorf("spool has %d segments, want the expired one deleted", n)
+	}
+}
+
+func TestSpoolFullHoldsBack(t *testing.T) {
+	dir, cleanup := tempSpool(t)
+	defer cleanup()
+	spool := openSpool(t, dir, 1024, 0)
+	defer spool.Close()
+
+	big := strings.Repeat("x", 600)
+	spoolSend(t, spool, "a"+big)
+	if err := spool.Send(spoolEvents("b" + big)); err == nil {
+		t.Fatalf("Send past the size limit succeeded")
+	}
+
+	// Draining makes room again
+	drainSpool(t, spool, &recordSink{}, 1, "a"+big)
+	spoolSend(t, spool, "b"+big)
+}
+
+// flakySink fails to flush the first time.
+type flakySink struct {
+	recordSink
+	failed bool
+}
+
+func (s *flakySink) Flush() error {
+	if !s.failed {
+		s.failed = true
+		return errors.New("connection reset")
+	}
+	return nil
+}
+
+func TestSpoolDrainResendsAfterFlushError(t *testing.T) {
+	dir, cleanup := tempSpool(t)
+	defer cleanup()
+
+	spool := openSpool(t, dir, 0, 0)
+	spoolSend(t, spool, "one", "two")
+	// The events that failed to flush are sent again, rather than skipped
+	drainSpool(t, spool, &flakySink{}, 4, "one", "two", "one", "two")
+	spool.Close()
+
+	spool = openSpool(t, dir, 0, 0)
+	defer spool.Close()
+	spoolSend(t, spool, "three")
+	drainSpool(t, spool, &recordSink{}, 1, "three")
+}
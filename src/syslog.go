@@ -0,0 +1,414 @@
+package rdstail
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Redials back off exponentially from syslogRedialMin to syslogRedialMax
+	syslogRedialMin = time.Second
+	syslogRedialMax = time.Minute
+	// syslogQueueSize is how many messages are held while the server can't
+	// be reached, unless SyslogConfig.QueueSize says otherwise
+	syslogQueueSize    = 10000
+	syslogWriteTimeout = 30 * time.Second
+)
+
+var syslogFacilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5,
+	"lpr": 6, "news": 7, "uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11,
+	"local0": 16, "local1": 17, "local2": 18, "local3": 19,
+	"local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+var syslogSeverities = map[string]int{
+	"emerg": 0, "alert": 1, "crit": 2, "err": 3, "error": 3,
+	"warning": 4, "warn": 4, "notice": 5, "info": 6, "debug": 7,
+}
+
+// ParseFacility reads a syslog facility given by name, e.g. local0, or number.
+func ParseFacility(v string) (int, error) {
+	return parseSyslogCode(v, syslogFacilities, 23, "facility")
+}
+
+// ParseSeverity reads a syslog severity given by name, e.g. info, or number.
+func ParseSeverity(v string) (int, error) {
+	return parseSyslogCode(v, syslogSeverities, 7, "severity")
+}
+
+// eventSeverities are the syslog severities of database log levels syslog
+// doesn't name itself
+var eventSeverities = map[string]int{
+	"panic": 0, "fatal": 2, "note": 5, "log": 6, "system": 6,
+}
+
+// syslogLevel is the syslog severity of a severity parsed from a log line,
+// e.g. FATAL or Warning.  Anything unknown is info.
+func syslogLevel(severity string) int {
+	severity = strings.ToLower(severity)
+	if n, ok := eventSeverities[severity]; ok {
+		return n
+	}
+	if n, ok := syslogSeverities[severity]; ok {
+		return n
+	}
+	if strings.HasPrefix(severity, "debug") {
+		return syslogSeverities["debug"]
+	}
+	return syslogSeverities["info"]
+}
+
+func parseSyslogCode(v string, names map[string]int, max int, what string) (int, error) {
+	if n, ok := names[strings.ToLower(v)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 || n > max {
+		return 0, fmt.Errorf("unknown syslog %s %q", what, v)
+	}
+	return n, nil
+}
+
+// SyslogConfig describes a syslog server and how to send messages to it.
+type SyslogConfig struct {
+	// Network is udp, tcp or tls
+	Network string
+	Addr    string
+	// Format is rfc5424 or rfc3164
+	Format string
+	// Framing of messages over tcp and tls: octet for RFC 6587 octet
+	// counting, or newline.  Defaults to octet.
+	Framing string
+	// CAFile holds the PEM certificates to verify a tls server with, instead
+	// of the system's
+	CAFile   string
+	Facility int
+	// Severity of messages whose log line had no severity of its own
+	Severity int
+	Hostname string
+	App      string
+	// MaxSize is the most message text sent in one frame, longer messages
+	// are split over several.  0 for no limit.
+	MaxSize int
+	// QueueSize is how many messages are held while the server can't be
+	// reached.  Past that the oldest are dropped.
+	QueueSize int
+}
+
+// SyslogWriter sends messages to a syslog server.  When a write fails the
+// connection is redialed in the background, backing off exponentially, and
+// messages are queued until it is back.
+type SyslogWriter struct {
+	cfg  SyslogConfig
+	dial func() (net.Conn, error)
+	stop chan struct{}
+
+	mu         sync.Mutex
+	conn       net.Conn
+	queue      [][]byte
+	failures   int
+	nextDial   time.Time
+	reconnects int64
+	dropped    int64
+}
+
+// SyslogStats counts what happened to a SyslogWriter's connection.
+type SyslogStats struct {
+	Reconnects int64
+	Dropped    int64
+	Queued     int
+}
+
+// DialSyslog connects to the syslog server described by cfg.
+func DialSyslog(cfg SyslogConfig) (*SyslogWriter, error) {
+	switch cfg.Format {
+	case "":
+		cfg.Format = "rfc5424"
+	case "rfc5424", "rfc3164":
+	default:
+		return nil, fmt.Errorf("unknown syslog format %q, expected rfc5424 or rfc3164", cfg.Format)
+	}
+	switch cfg.Framing {
+	case "":
+		cfg.Framing = "octet"
+	case "octet", "newline":
+	default:
+		return nil, fmt.Errorf("unknown syslog framing %q, expected octet or newline", cfg.Framing)
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = syslogQueueSize
+	}
+
+	var dial func() (net.Conn, error)
+	switch cfg.Network {
+	case "udp", "tcp":
+		dial = func() (net.Conn, error) {
+			return net.DialTimeout(cfg.Network, cfg.Addr, syslogWriteTimeout)
+		}
+	case "tls":
+		tlsConfig, err := loadTLSConfig(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		dial = func() (net.Conn, error) {
+			return tls.DialWithDialer(&net.Dialer{Timeout: syslogWriteTimeout}, "tcp", cfg.Addr, tlsConfig)
+		}
+	default:
+		return nil, fmt.Errorf("unknown syslog network %q, expected udp, tcp or tls", cfg.Network)
+	}
+
+	conn, err := dial()
+	if err != nil {
+		return nil, err
+	}
+	w := &SyslogWriter{cfg: cfg, dial: dial, conn: conn, stop: make(chan struct{})}
+	go w.redialLoop()
+	return w, nil
+}
+
+// loadTLSConfig makes a TLS config verifying servers against the certificates
+// in caFile, or the system's root certificates if caFile is empty.
+func loadTLSConfig(caFile string) (*tls.Config, error) {
+	if caFile == "" {
+		return &tls.Config{}, nil
+	}
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	return &tls.Config{RootCAs: roots}, nil
+}
+
+// Write sends msg as a syslog message logged at t, in chunks of at most
+// MaxSize.  severity is the one of the log line, e.g. ERROR, or empty for the
+// configured severity.
+func (w *SyslogWriter) Write(t time.Time, severity, msg string) error {
+	pri := w.cfg.Facility*8 + w.cfg.Severity
+	if severity != "" {
+		pri = w.cfg.Facility*8 + syslogLevel(severity)
+	}
+	msg = strings.TrimRight(msg, "\n")
+	for {
+		chunk := msg
+		if w.cfg.MaxSize > 0 && len(chunk) > w.cfg.MaxSize {
+			chunk = truncateUTF8(chunk, w.cfg.MaxSize)
+			if chunk == "" {
+				chunk = msg[:w.cfg.MaxSize]
+			}
+		}
+		if err := w.writeFrame(t, pri, chunk); err != nil {
+			return err
+		}
+		msg = msg[len(chunk):]
+		if msg == "" {
+			return nil
+		}
+	}
+}
+
+func (w *SyslogWriter) writeFrame(t time.Time, pri int, msg string) error {
+	var buf bytes.Buffer
+	w.format(&buf, t, pri, msg)
+	frame := buf.Bytes()
+	if w.cfg.Network != "udp" {
+		if w.cfg.Framing == "octet" {
+			frame = append([]byte(strconv.Itoa(len(frame))+" "), frame...)
+		} else {
+			frame = append(frame, '\n')
+		}
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.queue) == w.cfg.QueueSize {
+		w.queue = w.queue[1:]
+		w.dropped++
+		if w.dropped == 1 || w.dropped%1000 == 0 {
+			log.Printf("syslog: %s: queue full, %d messages dropped so far", w.cfg.Addr, w.dropped)
+		}
+	}
+	w.queue = append(w.queue, frame)
+	w.flush()
+	return nil
+}
+
+// flush writes out the queue, redialing first if the connection is down and
+// the backoff allows.  Must be called with mu held.
+func (w *SyslogWriter) flush() {
+	if w.conn == nil {
+		if time.Now().Before(w.nextDial) {
+			return
+		}
+		conn, err := w.dial()
+		if err != nil {
+			w.backOff(err)
+			return
+		}
+		w.conn = conn
+		w.reconnects++
+		w.failures = 0
+		log.Printf("syslog: reconnected to %s, %d reconnects and %d messages dropped so far", w.cfg.Addr, w.reconnects, w.dropped)
+	}
+
+	for len(w.queue) > 0 {
+		w.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout))
+		if _, err := w.conn.Write(w.queue[0]); err != nil {
+			// A frame cut short can't be taken back, the server has to make
+			// do with it and it is sent again in full
+			w.conn.Close()
+			w.conn = nil
+			w.backOff(err)
+			return
+		}
+		w.queue[0] = nil
+		w.queue = w.queue[1:]
+	}
+}
+
+// backOff schedules the next dial after a failure, doubling the wait each
+// time with some jitter so many writers don't redial in lockstep.
+func (w *SyslogWriter) backOff(err error) {
+	wait := syslogRedialMin << uint(w.failures)
+	if wait > syslogRedialMax || wait <= 0 {
+		wait = syslogRedialMax
+	} else {
+		w.failures++
+	}
+	wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+	w.nextDial = time.Now().Add(wait)
+	log.Printf("syslog: %s: %s, redialing in %s with %d messages queued", w.cfg.Addr, err, wait, len(w.queue))
+}
+
+// redialLoop keeps flushing the queue while the connection is down, so it
+// catches up without waiting for more messages.
+func (w *SyslogWriter) redialLoop() {
+	ticker := time.NewTicker(syslogRedialMin)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.mu.Lock()
+			if len(w.queue) > 0 {
+				w.flush()
+			}
+			w.mu.Unlock()
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+// Flush makes an attempt at writing out the queue, if the backoff allows.
+// Whatever is still queued after that is dropped, for the caller to write
+// again, and reported as an error.
+func (w *SyslogWriter) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.flush()
+	if n := len(w.queue); n > 0 {
+		w.queue = nil
+		return fmt.Errorf("syslog: %s: %d messages not delivered", w.cfg.Addr, n)
+	}
+	return nil
+}
+
+// Stats returns the reconnect and drop counts of the writer so far.
+func (w *SyslogWriter) Stats() SyslogStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return SyslogStats{Reconnects: w.reconnects, Dropped: w.dropped, Queued: len(w.queue)}
+}
+
+func (w *SyslogWriter) format(buf *bytes.Buffer, t time.Time, pri int, msg string) {
+	cfg := &w.cfg
+	fmt.Fprintf(buf, "<%d>", pri)
+	if cfg.Format == "rfc3164" {
+		fmt.Fprintf(buf, "%s %s %s: %s", t.UTC().Format(time.Stamp), syslogField(cfg.Hostname), syslogField(cfg.App), msg)
+		return
+	}
+	fmt.Fprintf(buf, "1 %s %s %s - - - %s", t.UTC().Format("2006-01-02T15:04:05.000000Z07:00"), syslogField(cfg.Hostname), syslogField(cfg.App), msg)
+}
+
+// syslogField makes v fit a header field, which can't be empty or hold spaces.
+func syslogField(v string) string {
+	v = strings.Join(strings.Fields(v), "_")
+	if v == "" {
+		return "-"
+	}
+	return v
+}
+
+// Close makes a last attempt at sending what is queued and closes the
+// connection.
+func (w *SyslogWriter) Close() error {
+	close(w.stop)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.nextDial = time.Time{}
+	w.flush()
+	if len(w.queue) > 0 {
+		log.Printf("syslog: %s: closing with %d messages undelivered", w.cfg.Addr, len(w.queue))
+	}
+	if w.conn == nil {
+		return nil
+	}
+	return w.conn.Close()
+}
+
+// SyslogSink sends each event to a syslog server as a message of its own,
+// logged at the time and severity the event was.  With instances or files
+// set lines are prefixed with the instance or log file they came from, as in
+// LinePrefix.
+type SyslogSink struct {
+	w         *SyslogWriter
+	instances bool
+	files     bool
+}
+
+func NewSyslogSink(w *SyslogWriter, instances, files bool) *SyslogSink {
+	return &SyslogSink{w: w, instances: instances, files: files}
+}
+
+func (s *SyslogSink) Send(events []Event) error {
+	now := time.Now()
+	for i := range events {
+		e := &events[i]
+		msg := e.Raw
+		if msg == "" {
+			continue
+		}
+		if s.instances || s.files {
+			msg = PrefixLines(LinePrefix(e.Instance, e.LogFile, s.instances, s.files), msg)
+		}
+		if err := s.w.Write(e.Timestamp(now), e.Severity, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush succeeds once every event sent so far is written to the connection.
+// With a spool this keeps events there until then, rather than in the
+// writer's queue where they would be lost on exit.
+func (s *SyslogSink) Flush() error {
+	return s.w.Flush()
+}
+
+func (s *SyslogSink) Close() error {
+	return s.w.Close()
+}
@@ -0,0 +1,377 @@
+package rdstail_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/litl/rdstail/src"
+	"github.com/litl/rdstail/src/parser"
+)
+
+// syslogServer accepts connections on a tcp listener and keeps the bytes it
+// receives on them, in the order the connections were made.
+type syslogServer struct {
+	net.Listener
+
+	mu    sync.Mutex
+	conns []net.Conn
+	data  []*[]byte
+}
+
+func newSyslogServer(t *testing.T) *syslogServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return serveSyslog(l)
+}
+
+// newTLSSyslogServer serves tls with a self-signed certificate for
+// 127.0.0.1, written to caFile for clients to verify it with.
+func newTLSSyslogServer(t *testing.T) (s *syslogServer, caFile string, cleanup func()) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "syslog test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := ioutil.TempFile("", "rdstail-ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pem.Encode(f, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	f.Close()
+
+	cert := tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	if err != nil {
+		os.Remove(f.Name())
+		t.Fatal(err)
+	}
+	s = serveSyslog(l)
+	return s, f.Name(), func() {
+		s.Close()
+		os.Remove(f.Name())
+	}
+}
+
+func serveSyslog(l net.Listener) *syslogServer {
+	s := &syslogServer{Listener: l}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			data := new([]byte)
+			s.mu.Lock()
+			s.conns = append(s.conns, conn)
+			s.data = append(s.data, data)
+			s.mu.Unlock()
+			go func() {
+				buf := make([]byte, 4096)
+				for {
+					n, err := conn.Read(buf)
+					s.mu.Lock()
+					*data = append(*data, buf[:n]...)
+					s.mu.Unlock()
+					if err != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+	return s
+}
+
+// hangUp closes the listener and every connection made to it.
+func (s *syslogServer) hangUp() {
+	s.Close()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.conns {
+		conn.Close()
+	}
+}
+
+// received returns what came in over every connection so far.
+func (s *syslogServer) received() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var all []byte
+	for _, data := range s.data {
+		all = append(all, *data...)
+	}
+	return string(all)
+}
+
+// waitReceived waits for want to come in, over however many connections.
+func (s *syslogServer) waitReceived(t *testing.T, want string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got := s.received()
+		if got == want {
+			return
+		}
+		if len(got) >= len(want) || time.Now().After(deadline) {
+			t.Fatalf("server received %q, want %q", got, want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func (s *syslogServer) config() rdstail.SyslogConfig {
+	return rdstail.SyslogConfig{
+		Network:  "tcp",
+		Addr:     s.Addr().String(),
+		Framing:  "newline",
+		Facility: 16, // local0
+		Severity: 5,  // notice
+		Hostname: "client host",
+		App:      "rdstail",
+	}
+}
+
+func dialSyslog(t *testing.T, cfg rdstail.SyslogConfig) *rdstail.SyslogWriter {
+	t.Helper()
+	w, err := rdstail.DialSyslog(cfg)
+	if err != nil {
+		t.Fatalf("DialSyslog: %s", err)
+	}
+	return w
+}
+
+func syslogEvents(when time.Time) []rdstail.Event {
+	return []rdstail.Event{
+		{Instance: rdstail.Instance{ID: testDB}, LogFile: "error/postgresql.log.00", Event: parser.Event{Time: when, Severity: "ERROR", Raw: "oops"}},
+		{Instance: rdstail.Instance{ID: testDB}, LogFile: "error/postgresql.log.00", Event: parser.Event{Time: when, Severity: "WARNING", Raw: "careful"}},
+		{Instance: rdstail.Instance{ID: testDB}, LogFile: "error/postgresql.log.00", Event: parser.Event{Time: when, Raw: "no level"}},
+	}
+}
+
+func TestSyslogFormats(t *testing.T) {
+	when := time.Date(2020, 1, 2, 10, 4, 5, 123456000, time.UTC)
+	for _, tc := range []struct {
+		format string
+		want   string
+	}{
+		{"rfc5424", "<131>1 2020-01-02T10:04:05.123456Z client_host rdstail - - - oops\n" +
+			"<132>1 2020-01-02T10:04:05.123456Z client_host rdstail - - - careful\n" +
+			"<133>1 2020-01-02T10:04:05.123456Z client_host rdstail - - - no level\n"},
+		{"rfc3164", "<131>Jan  2 10:04:05 client_host rdstail: oops\n" +
+			"<132>Jan  2 10:04:05 client_host rdstail: careful\n" +
+			"<133>Jan  2 10:04:05 client_host rdstail: no level\n"},
+	} {
+		srv := newSyslogServer(t)
+		cfg := srv.config()
+		cfg.Format = tc.format
+		sink := rdstail.NewSyslogSink(dialSyslog(t, cfg), false, false)
+		if err := sink.Send(syslogEvents(when)); err != nil {
+			t.Fatalf("Send: %s", err)
+		}
+		srv.waitReceived(t, tc.want)
+		sink.Close()
+		srv.Close()
+	}
+}
+
+func TestSyslogSplitsLongMessages(t *testing.T) {
+	srv := newSyslogServer(t)
+	defer srv.Close()
+	cfg := srv.config()
+	cfg.Format = "rfc3164"
+	cfg.MaxSize = 5
+	w := dialSyslog(t, cfg)
+	defer w.Close()
+
+	// Chunks don't cut a character in two
+	when := time.Date(2020, 1, 2, 10, 4, 5, 0, time.UTC)
+	if err := w.Write(when, "", "0123456789abcdé\n"); err != nil {
+		t.Fatalf("Write: %s", err)
+	}
+	srv.waitReceived(t, "<133>Jan  2 10:04:05 client_host rdstail: 01234\n"+
+		"<133>Jan  2 10:04:05 client_host rdstail: 56789\n"+
+		"<133>Jan  2 10:04:05 client_host rdstail: abcd\n"+
+		"<133>Jan  2 10:04:05 client_host rdstail: é\n")
+}
+
+// splitFrames splits octet counted frames, failing on a count that's off.
+func splitFrames(t *testing.T, data string) []string {
+	t.Helper()
+	var frames []string
+	for data != "" {
+		sp := strings.IndexByte(data, ' ')
+		if sp <= 0 {
+			t.Fatalf("no frame length in %q", data)
+		}
+		n, err := strconv.Atoi(data[:sp])
+		if err != nil {
+			t.Fatalf("bad frame length in %q", data)
+		}
+		if len(data) < sp+1+n {
+			t.Fatalf("frame of %d bytes cut short in %q", n, data)
+		}
+		frames = append(frames, data[sp+1:sp+1+n])
+		data = data[sp+1+n:]
+	}
+	return frames
+}
+
+func TestSyslogFraming(t *testing.T) {
+	when := time.Date(2020, 1, 2, 10, 4, 5, 0, time.UTC)
+	for _, framing := range []string{"octet", "newline"} {
+		srv := newSyslogServer(t)
+		cfg := srv.config()
+		cfg.Format = "rfc3164"
+		cfg.Framing = framing
+		w := dialSyslog(t, cfg)
+		// Lines within a message don't end its frame
+		if err := w.Write(when, "", "ERROR:  oops\nSTATEMENT:  select 1"); err != nil {
+			t.Fatalf("Write: %s", err)
+		}
+		if err := w.Write(when, "", "next"); err != nil {
+			t.Fatalf("Write: %s", err)
+		}
+		first := "<133>Jan  2 10:04:05 client_host rdstail: ERROR:  oops\nSTATEMENT:  select 1"
+		second := "<133>Jan  2 10:04:05 client_host rdstail: next"
+		if framing == "octet" {
+			srv.waitReceived(t, strconv.Itoa(len(first))+" "+first+strconv.Itoa(len(second))+" "+second)
+			if frames := splitFrames(t, srv.received()); len(frames) != 2 || frames[0] != first || frames[1] != second {
+				t.Errorf("got frames %q, want %q and %q", frames, first, second)
+			}
+		} else {
+			srv.waitReceived(t, first+"\n"+second+"\n")
+		}
+		w.Close()
+		srv.Close()
+	}
+}
+
+func TestSyslogTLS(t *testing.T) {
+	srv, caFile, cleanup := newTLSSyslogServer(t)
+	defer cleanup()
+	cfg := srv.config()
+	cfg.Network = "tls"
+	cfg.Format = "rfc3164"
+
+	// The server's certificate is nobody the system trusts
+	if w, err := rdstail.DialSyslog(cfg); err == nil {
+		w.Close()
+		t.Fatalf("dialing an unknown server's certificate succeeded")
+	}
+
+	cfg.CAFile = caFile
+	w := dialSyslog(t, cfg)
+	defer w.Close()
+	when := time.Date(2020, 1, 2, 10, 4, 5, 0, time.UTC)
+	if err := w.Write(when, "ERROR", "oops"); err != nil {
+		t.Fatalf("Write: %s", err)
+	}
+	srv.waitReceived(t, "<131>Jan  2 10:04:05 client_host rdstail: oops\n")
+}
+
+func TestPapertrailPreset(t *testing.T) {
+	srv, caFile, cleanup := newTLSSyslogServer(t)
+	defer cleanup()
+
+	cfg := rdstail.PapertrailConfig(srv.Addr().String(), "my app", "client host")
+	if w, err := rdstail.DialSyslog(cfg); err == nil {
+		w.Close()
+		t.Fatalf("papertrail preset dialed without verifying the server")
+	}
+
+	cfg.CAFile = caFile
+	sink := rdstail.NewSyslogSink(dialSyslog(t, cfg), false, false)
+	defer sink.Close()
+	if err := sink.Send(syslogEvents(time.Date(2020, 1, 2, 10, 4, 5, 0, time.UTC))[:1]); err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+	// user.err, rfc5424, octet counted
+	msg := "<11>1 2020-01-02T10:04:05.000000Z client_host my_app - - - oops"
+	srv.waitReceived(t, strconv.Itoa(len(msg))+" "+msg)
+}
+
+func TestSyslogRedialsAndQueues(t *testing.T) {
+	srv := newSyslogServer(t)
+	addr := srv.Addr().String()
+	cfg := srv.config()
+	cfg.Format = "rfc3164"
+	cfg.QueueSize = 3
+	w := dialSyslog(t, cfg)
+	defer w.Close()
+	when := time.Date(2020, 1, 2, 10, 4, 5, 0, time.UTC)
+	line := func(msg string) string {
+		return "<133>Jan  2 10:04:05 client_host rdstail: " + msg + "\n"
+	}
+
+	if err := w.Write(when, "", "before"); err != nil {
+		t.Fatalf("Write: %s", err)
+	}
+	srv.waitReceived(t, line("before"))
+
+	// Writes only start failing once the server's hang up has come back
+	srv.hangUp()
+	deadline := time.Now().Add(5 * time.Second)
+	for i := 0; w.Stats().Queued == 0; i++ {
+		if time.Now().After(deadline) {
+			t.Fatalf("writes kept succeeding after the server hung up")
+		}
+		if err := w.Write(when, "", "lost "+strconv.Itoa(i)); err != nil {
+			t.Fatalf("Write: %s", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	queued := w.Stats().Queued
+
+	// Past the queue size the oldest messages make way
+	for i := 0; i < 5; i++ {
+		if err := w.Write(when, "", "queued "+strconv.Itoa(i)); err != nil {
+			t.Fatalf("Write: %s", err)
+		}
+	}
+	stats := w.Stats()
+	if stats.Queued != 3 || stats.Dropped != int64(queued+5-3) || stats.Reconnects != 0 {
+		t.Errorf("got stats %+v, want 3 queued, %d dropped and no reconnects", stats, queued+5-3)
+	}
+
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		t.Fatalf("listening again on %s: %s", addr, err)
+	}
+	srv = serveSyslog(l)
+	defer srv.Close()
+	srv.waitReceived(t, line("queued 2")+line("queued 3")+line("queued 4"))
+	waitUntil(t, "the queue to empty", func() bool { return w.Stats().Queued == 0 })
+	if stats := w.Stats(); stats.Reconnects != 1 {
+		t.Errorf("got %d reconnects, want 1", stats.Reconnects)
+	}
+}